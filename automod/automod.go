@@ -0,0 +1,460 @@
+// Package automod evaluates posts and comments against an AutoModerator config locally,
+// so that changes to a subreddit's config can be tried on content fetched with the reddit
+// package before they are deployed.
+//
+// Only a subset of the AutoModerator rule language is supported:
+//   - type: submission, text submission, link submission, comment, any
+//   - title, body, domain and url checks, including combined fields (e.g. title+body),
+//     negation (~title) and the includes-word, includes, starts-with, ends-with, full-exact,
+//     full-text, regex and case-sensitive modifiers
+//   - author comment_karma, post_karma, combined_karma and account_age thresholds, and
+//     satisfy_any_threshold
+//
+// Of the keys that act on a match, only action and action_reason are kept; the others, such as
+// comment or set_flair, are ignored since nothing is acted on. Parsing a config that uses any
+// other check returns an error rather than silently evaluating the rule differently from Reddit.
+// So do priority and moderators_exempt, which change the order rules run in and whom they
+// apply to.
+//
+// AutoModerator docs: https://www.reddit.com/wiki/automoderator/full-documentation
+package automod
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+	"gopkg.in/yaml.v2"
+)
+
+// Config is a parsed AutoModerator config.
+type Config struct {
+	Rules []*Rule
+}
+
+// Rule is one rule of an AutoModerator config, i.e. one YAML document.
+type Rule struct {
+	// One of: submission, text submission, link submission, comment, any.
+	Type string
+	// One of: approve, remove, spam, filter, report. Empty if the rule has no action.
+	Action       string
+	ActionReason string
+
+	checks []*check
+	author *authorCheck
+}
+
+// actionKeys are the keys of a rule that do something when it matches, rather than decide
+// whether it matches.
+var actionKeys = map[string]bool{
+	"action":             true,
+	"action_reason":      true,
+	"comment":            true,
+	"comment_locked":     true,
+	"comment_stickied":   true,
+	"message":            true,
+	"message_subject":    true,
+	"modmail":            true,
+	"modmail_subject":    true,
+	"overwrite_flair":    true,
+	"report_reason":      true,
+	"set_contest_mode":   true,
+	"set_flair":          true,
+	"set_locked":         true,
+	"set_nsfw":           true,
+	"set_spoiler":        true,
+	"set_sticky":         true,
+	"set_suggested_sort": true,
+}
+
+// Parse parses an AutoModerator config, i.e. YAML documents separated by "---".
+func Parse(data []byte) (*Config, error) {
+	config := new(Config)
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for i := 1; ; i++ {
+		var doc map[string]interface{}
+		err := decoder.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		rule, err := parseRule(doc)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		config.Rules = append(config.Rules, rule)
+	}
+
+	return config, nil
+}
+
+func parseRule(doc map[string]interface{}) (*Rule, error) {
+	rule := &Rule{Type: "any"}
+
+	for key, value := range doc {
+		switch key {
+		case "type":
+			rule.Type = fmt.Sprint(value)
+			switch rule.Type {
+			case "submission", "text submission", "link submission", "comment", "any":
+				// intentionally left blank
+			default:
+				return nil, fmt.Errorf("type: unsupported value %q", rule.Type)
+			}
+		case "action":
+			rule.Action = fmt.Sprint(value)
+		case "action_reason":
+			rule.ActionReason = fmt.Sprint(value)
+		case "moderators_exempt", "priority":
+			return nil, fmt.Errorf("%s: unsupported key", key)
+		case "author":
+			author, err := parseAuthorCheck(value)
+			if err != nil {
+				return nil, fmt.Errorf("author: %w", err)
+			}
+			rule.author = author
+		default:
+			if actionKeys[key] {
+				continue
+			}
+			check, err := parseCheck(key, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			rule.checks = append(rule.checks, check)
+		}
+	}
+
+	return rule, nil
+}
+
+// check is a search check, e.g. "~title+body (regex)": ["spam", "eggs"].
+type check struct {
+	fields []string
+	negate bool
+	re     *regexp.Regexp
+}
+
+var checkKeyRegexp = regexp.MustCompile(`^(~?)([a-z_+]+)\s*(?:\(([^)]*)\))?$`)
+
+func parseCheck(key string, value interface{}) (*check, error) {
+	m := checkKeyRegexp.FindStringSubmatch(key)
+	if m == nil {
+		return nil, errors.New("unsupported check")
+	}
+
+	c := &check{negate: m[1] == "~", fields: strings.Split(m[2], "+")}
+	for _, field := range c.fields {
+		switch field {
+		case "title", "body", "domain", "url":
+			// intentionally left blank
+		default:
+			return nil, fmt.Errorf("unsupported field %q", field)
+		}
+	}
+
+	mode := "includes-word"
+	if len(c.fields) == 1 && c.fields[0] == "domain" {
+		mode = "domain"
+	} else if len(c.fields) == 1 && c.fields[0] == "url" {
+		mode = "includes"
+	}
+
+	caseSensitive := false
+	if m[3] != "" {
+		for _, modifier := range strings.Split(m[3], ",") {
+			switch modifier = strings.TrimSpace(modifier); modifier {
+			case "case-sensitive":
+				caseSensitive = true
+			case "includes-word", "includes", "starts-with", "ends-with", "full-exact", "full-text", "regex":
+				mode = modifier
+			default:
+				return nil, fmt.Errorf("unsupported modifier %q", modifier)
+			}
+		}
+	}
+
+	values, err := stringList(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, errors.New("must provide at least 1 value")
+	}
+
+	for i, v := range values {
+		if mode != "regex" {
+			values[i] = regexp.QuoteMeta(v)
+		}
+	}
+	pattern := "(?:" + strings.Join(values, "|") + ")"
+
+	switch mode {
+	case "includes-word":
+		pattern = `(?:^|\W)` + pattern + `(?:\W|$)`
+	case "starts-with":
+		pattern = "^" + pattern
+	case "ends-with":
+		pattern = pattern + "$"
+	case "full-exact":
+		pattern = "^" + pattern + "$"
+	case "full-text":
+		pattern = `^\W*` + pattern + `\W*$`
+	case "domain":
+		pattern = `(?:^|\.)` + pattern + "$"
+	}
+	if !caseSensitive {
+		pattern = "(?i)" + pattern
+	}
+
+	c.re, err = regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func stringList(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = fmt.Sprint(item)
+		}
+		return values, nil
+	case nil:
+		return nil, nil
+	case map[interface{}]interface{}:
+		return nil, errors.New("must be a value or a list of values")
+	default:
+		return []string{fmt.Sprint(v)}, nil
+	}
+}
+
+// authorCheck holds the thresholds the author of a post or comment must meet.
+type authorCheck struct {
+	thresholds []*threshold
+	satisfyAny bool
+}
+
+type threshold struct {
+	name    string
+	less    bool
+	orEqual bool
+	value   float64
+}
+
+var thresholdRegexp = regexp.MustCompile(`^([<>]=?)\s*([0-9.]+)\s*([a-z]*)$`)
+
+var accountAgeUnits = map[string]time.Duration{
+	"minutes": time.Minute,
+	"hours":   time.Hour,
+	"days":    time.Hour * 24,
+	"weeks":   time.Hour * 24 * 7,
+	"months":  time.Hour * 24 * 30,
+	"years":   time.Hour * 24 * 365,
+}
+
+func parseAuthorCheck(value interface{}) (*authorCheck, error) {
+	fields, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("must be a mapping")
+	}
+
+	a := new(authorCheck)
+	for k, v := range fields {
+		key := fmt.Sprint(k)
+		switch key {
+		case "satisfy_any_threshold":
+			any, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be true or false", key)
+			}
+			a.satisfyAny = any
+		case "comment_karma", "post_karma", "combined_karma", "account_age":
+			m := thresholdRegexp.FindStringSubmatch(strings.TrimSpace(fmt.Sprint(v)))
+			if m == nil {
+				return nil, fmt.Errorf("%s: must be a comparison such as \"< 10\"", key)
+			}
+
+			n, err := strconv.ParseFloat(m[2], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+
+			if key == "account_age" {
+				unit := m[3]
+				if unit == "" {
+					unit = "days"
+				}
+				if !strings.HasSuffix(unit, "s") {
+					unit += "s"
+				}
+				d, ok := accountAgeUnits[unit]
+				if !ok {
+					return nil, fmt.Errorf("%s: unsupported unit %q", key, m[3])
+				}
+				n *= float64(d)
+			} else if m[3] != "" {
+				return nil, fmt.Errorf("%s: must be a number", key)
+			}
+
+			a.thresholds = append(a.thresholds, &threshold{
+				name:    key,
+				less:    m[1][0] == '<',
+				orEqual: strings.HasSuffix(m[1], "="),
+				value:   n,
+			})
+		default:
+			return nil, fmt.Errorf("unsupported check %q", key)
+		}
+	}
+
+	return a, nil
+}
+
+func (t *threshold) met(author *reddit.User, now time.Time) bool {
+	var v float64
+	switch t.name {
+	case "comment_karma":
+		v = float64(author.CommentKarma)
+	case "post_karma":
+		v = float64(author.PostKarma)
+	case "combined_karma":
+		v = float64(author.CommentKarma + author.PostKarma)
+	case "account_age":
+		if author.Created == nil {
+			return false
+		}
+		v = float64(now.Sub(author.Created.Time))
+	}
+
+	switch {
+	case t.orEqual && v == t.value:
+		return true
+	case t.less:
+		return v < t.value
+	default:
+		return v > t.value
+	}
+}
+
+func (a *authorCheck) met(author *reddit.User, now time.Time) bool {
+	if author == nil {
+		return false
+	}
+	if len(a.thresholds) == 0 {
+		return true
+	}
+
+	for _, t := range a.thresholds {
+		met := t.met(author, now)
+		if met && a.satisfyAny {
+			return true
+		}
+		if !met && !a.satisfyAny {
+			return false
+		}
+	}
+	return !a.satisfyAny
+}
+
+// item holds the fields of a post or comment that checks search.
+type item struct {
+	kind   string
+	fields map[string]string
+	author *reddit.User
+}
+
+func (r *Rule) matches(it *item, now time.Time) bool {
+	switch r.Type {
+	case "submission":
+		if it.kind == "comment" {
+			return false
+		}
+	case "text submission", "link submission", "comment":
+		if it.kind != r.Type {
+			return false
+		}
+	}
+
+	for _, c := range r.checks {
+		found := false
+		for _, field := range c.fields {
+			if c.re.MatchString(it.fields[field]) {
+				found = true
+				break
+			}
+		}
+		if found == c.negate {
+			return false
+		}
+	}
+
+	if r.author != nil && !r.author.met(it.author, now) {
+		return false
+	}
+
+	return true
+}
+
+func (c *Config) match(it *item) []*Rule {
+	now := time.Now()
+
+	var rules []*Rule
+	for _, rule := range c.Rules {
+		if rule.matches(it, now) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// MatchPost returns the rules that the post matches, in the order they appear in the config.
+// Author checks need the post's author, e.g. from (*reddit.UserService).Get; rules with author
+// checks never match if author is nil.
+func (c *Config) MatchPost(post *reddit.Post, author *reddit.User) []*Rule {
+	it := &item{
+		kind: "link submission",
+		fields: map[string]string{
+			"title": post.Title,
+			"body":  post.Body,
+			"url":   post.URL,
+		},
+		author: author,
+	}
+
+	if post.IsSelfPost {
+		it.kind = "text submission"
+		it.fields["domain"] = "self." + post.SubredditName
+	} else if u, err := url.Parse(post.URL); err == nil {
+		it.fields["domain"] = strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	}
+
+	return c.match(it)
+}
+
+// MatchComment returns the rules that the comment matches, in the order they appear in the config.
+// Only body and author checks apply to comments; title, domain and url checks look at empty text.
+func (c *Config) MatchComment(comment *reddit.Comment, author *reddit.User) []*Rule {
+	return c.match(&item{
+		kind:   "comment",
+		fields: map[string]string{"body": comment.Body},
+		author: author,
+	})
+}
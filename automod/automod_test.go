@@ -0,0 +1,125 @@
+package automod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = `
+# Remove links to spam domains.
+type: link submission
+domain: [spam.com, "scam.net"]
+action: spam
+action_reason: Spam domain {{domain}}
+---
+type: submission
+title+body (regex): ['free\s+money', 'crypto\s+giveaway']
+author:
+    account_age: < 7 days
+    combined_karma: < 10
+action: filter
+---
+type: comment
+~body (includes): ["?"]
+body (starts-with, case-sensitive): "AMA"
+action: report
+---
+# An empty document is skipped.
+---
+type: comment
+body: hello
+author:
+    comment_karma: "> 100"
+    post_karma: "> 100"
+    satisfy_any_threshold: true
+action: approve
+`
+
+func TestParse(t *testing.T) {
+	config, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+	require.Len(t, config.Rules, 4)
+
+	require.Equal(t, "link submission", config.Rules[0].Type)
+	require.Equal(t, "spam", config.Rules[0].Action)
+	require.Equal(t, "Spam domain {{domain}}", config.Rules[0].ActionReason)
+	require.Equal(t, "submission", config.Rules[1].Type)
+	require.Equal(t, "filter", config.Rules[1].Action)
+	require.Equal(t, "report", config.Rules[2].Action)
+	require.Equal(t, "approve", config.Rules[3].Action)
+
+	_, err = Parse([]byte("type: comment\nauthor:\n    is_contributor: true"))
+	require.EqualError(t, err, `rule 1: author: unsupported check "is_contributor"`)
+
+	_, err = Parse([]byte("title: a\n---\nflair_text: b"))
+	require.EqualError(t, err, `rule 2: flair_text: unsupported field "flair_text"`)
+
+	_, err = Parse([]byte("title (includes-words): a"))
+	require.EqualError(t, err, `rule 1: title (includes-words): unsupported modifier "includes-words"`)
+
+	_, err = Parse([]byte("type: modmail"))
+	require.EqualError(t, err, `rule 1: type: unsupported value "modmail"`)
+
+	_, err = Parse([]byte("title: a\npriority: 1"))
+	require.EqualError(t, err, `rule 1: priority: unsupported key`)
+
+	_, err = Parse([]byte("title: a\nmoderators_exempt: false"))
+	require.EqualError(t, err, `rule 1: moderators_exempt: unsupported key`)
+
+	_, err = Parse([]byte("author:\n    post_karma: 10"))
+	require.EqualError(t, err, `rule 1: author: post_karma: must be a comparison such as "< 10"`)
+}
+
+func TestConfig_MatchPost(t *testing.T) {
+	config, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	newUser := &reddit.User{
+		Created:      &reddit.Timestamp{Time: time.Now().Add(-time.Hour * 24)},
+		PostKarma:    1,
+		CommentKarma: 2,
+	}
+	oldUser := &reddit.User{
+		Created:      &reddit.Timestamp{Time: time.Now().Add(-time.Hour * 24 * 365)},
+		PostKarma:    1,
+		CommentKarma: 2,
+	}
+
+	post := &reddit.Post{Title: "Link", URL: "https://www.blog.spam.com/article"}
+	require.Equal(t, []*Rule{config.Rules[0]}, config.MatchPost(post, nil))
+
+	post = &reddit.Post{Title: "Link", URL: "https://notspam.com/article"}
+	require.Empty(t, config.MatchPost(post, nil))
+
+	post = &reddit.Post{Title: "FREE  money!", IsSelfPost: true, SubredditName: "test"}
+	require.Equal(t, []*Rule{config.Rules[1]}, config.MatchPost(post, newUser))
+	require.Empty(t, config.MatchPost(post, oldUser))
+	require.Empty(t, config.MatchPost(post, nil))
+
+	post = &reddit.Post{Title: "Question", Body: "Anyone seen the crypto giveaway?", IsSelfPost: true}
+	require.Equal(t, []*Rule{config.Rules[1]}, config.MatchPost(post, newUser))
+}
+
+func TestConfig_MatchComment(t *testing.T) {
+	config, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	comment := &reddit.Comment{Body: "AMA about automod"}
+	require.Equal(t, []*Rule{config.Rules[2]}, config.MatchComment(comment, nil))
+
+	comment = &reddit.Comment{Body: "AMA about automod?"}
+	require.Empty(t, config.MatchComment(comment, nil))
+
+	comment = &reddit.Comment{Body: "ama about automod"}
+	require.Empty(t, config.MatchComment(comment, nil))
+
+	comment = &reddit.Comment{Body: "Hello, world"}
+	require.Equal(t, []*Rule{config.Rules[3]}, config.MatchComment(comment, &reddit.User{CommentKarma: 500}))
+	require.Empty(t, config.MatchComment(comment, &reddit.User{CommentKarma: 5}))
+
+	comment = &reddit.Comment{Body: "Othello"}
+	require.Empty(t, config.MatchComment(comment, &reddit.User{CommentKarma: 500}))
+}
@@ -0,0 +1,152 @@
+// Package bridge converts Reddit posts, comments and messages into notifications for chat
+// platforms, so that activity on Reddit can be mirrored into e.g. a Discord or Slack channel.
+//
+// A Notification is built from an item with FromPost, FromComment or FromMessage, turned into
+// a platform's webhook payload by a Formatter, and sent with Send:
+//
+//	n := bridge.FromPost(post)
+//	err := bridge.Send(ctx, http.DefaultClient, webhookURL, &bridge.DiscordFormatter{}, n)
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+)
+
+const defaultBaseURL = "https://www.reddit.com"
+
+// Kind is the kind of Reddit item a notification is about.
+type Kind string
+
+const (
+	// KindPost is a notification about a post.
+	KindPost Kind = "post"
+	// KindComment is a notification about a comment.
+	KindComment Kind = "comment"
+	// KindMessage is a notification about a private message.
+	KindMessage Kind = "message"
+)
+
+// Notification is a Reddit item, as it should be shown in a chat platform.
+type Notification struct {
+	Kind Kind
+	// The post's title, the title of the post a comment was made in, or a message's subject.
+	Title string
+	// The item's markdown text. May be empty, e.g. for link posts.
+	Body string
+	// A link to the item on Reddit. Empty for messages.
+	URL string
+
+	Author    string
+	Subreddit string
+	Created   time.Time
+
+	// Formatting hints. Formatters should hide the body of spoilers and flag NSFW items.
+	NSFW    bool
+	Spoiler bool
+}
+
+// FromPost returns a notification about the post.
+func FromPost(post *reddit.Post) *Notification {
+	n := &Notification{
+		Kind:      KindPost,
+		Title:     post.Title,
+		Body:      post.Body,
+		URL:       defaultBaseURL + post.Permalink,
+		Author:    post.Author,
+		Subreddit: post.SubredditName,
+		NSFW:      post.NSFW,
+		Spoiler:   post.Spoiler,
+	}
+	if post.Created != nil {
+		n.Created = post.Created.Time
+	}
+	return n
+}
+
+// FromComment returns a notification about the comment.
+func FromComment(comment *reddit.Comment) *Notification {
+	n := &Notification{
+		Kind:      KindComment,
+		Title:     comment.PostTitle,
+		Body:      comment.Body,
+		URL:       defaultBaseURL + comment.Permalink,
+		Author:    comment.Author,
+		Subreddit: comment.SubredditName,
+		NSFW:      comment.NSFW,
+	}
+	if comment.Created != nil {
+		n.Created = comment.Created.Time
+	}
+	return n
+}
+
+// FromMessage returns a notification about the message.
+func FromMessage(message *reddit.Message) *Notification {
+	n := &Notification{
+		Kind:   KindMessage,
+		Title:  message.Subject,
+		Body:   message.Text,
+		Author: message.Author,
+	}
+	if message.Created != nil {
+		n.Created = message.Created.Time
+	}
+	return n
+}
+
+// Formatter turns a notification into the JSON payload of a chat platform's incoming webhook.
+type Formatter interface {
+	Format(n *Notification) ([]byte, error)
+}
+
+// Send formats the notification and posts it to the webhook URL.
+// It returns an error if the webhook responds with a status code outside of the 2xx range.
+func Send(ctx context.Context, client *http.Client, webhookURL string, f Formatter, n *Notification) error {
+	if n == nil {
+		return errors.New("*Notification: cannot be nil")
+	}
+
+	payload, err := f.Format(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if c := resp.StatusCode; c < 200 || c > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook responded with %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// truncate shortens s to at most n runes, ending it with an ellipsis if it was shortened.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if n <= 0 || len(r) <= n {
+		return s
+	}
+	if n == 1 {
+		return "…"
+	}
+	return string(r[:n-1]) + "…"
+}
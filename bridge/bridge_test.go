@@ -0,0 +1,119 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+	"github.com/stretchr/testify/require"
+)
+
+var testPost = &reddit.Post{
+	Created:       &reddit.Timestamp{Time: time.Date(2020, 7, 18, 10, 26, 7, 0, time.UTC)},
+	Permalink:     "/r/test/comments/hw6l6a/test_post/",
+	Title:         "Test <post>",
+	Body:          "Some **bold** text and [a link](https://example.com).",
+	SubredditName: "test",
+	Author:        "testuser",
+}
+
+func TestFromComment(t *testing.T) {
+	n := FromComment(&reddit.Comment{
+		Permalink:     "/r/test/comments/hw6l6a/test_post/fz0dn6q/",
+		Body:          "a reply",
+		Author:        "testuser",
+		SubredditName: "test",
+		PostTitle:     "Test post",
+	})
+	require.Equal(t, &Notification{
+		Kind:      KindComment,
+		Title:     "Test post",
+		Body:      "a reply",
+		URL:       "https://www.reddit.com/r/test/comments/hw6l6a/test_post/fz0dn6q/",
+		Author:    "testuser",
+		Subreddit: "test",
+	}, n)
+}
+
+func TestDiscordFormatter(t *testing.T) {
+	f := &DiscordFormatter{Username: "bot", Color: 0xFF4500}
+
+	payload, err := f.Format(FromPost(testPost))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"username": "bot",
+		"embeds": [{
+			"title": "Test <post>",
+			"description": "Some **bold** text and [a link](https://example.com).",
+			"url": "https://www.reddit.com/r/test/comments/hw6l6a/test_post/",
+			"color": 16729344,
+			"timestamp": "2020-07-18T10:26:07Z",
+			"author": {"name": "u/testuser"},
+			"footer": {"text": "r/test · post"}
+		}]
+	}`, string(payload))
+
+	f = &DiscordFormatter{MaxBodyLength: 10}
+	payload, err = f.Format(&Notification{Kind: KindMessage, Title: "hi", Body: "a long secret message", Spoiler: true, NSFW: true})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"embeds": [{"title": "[NSFW] hi", "description": "||a lon…||"}]}`, string(payload))
+
+	f = &DiscordFormatter{MaxBodyLength: 3}
+	payload, err = f.Format(&Notification{Kind: KindMessage, Title: "hi", Body: "a long secret message", Spoiler: true})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"embeds": [{"title": "hi", "description": "||…||"}]}`, string(payload))
+
+	payload, err = f.Format(&Notification{Kind: KindMessage, Title: "hi", Spoiler: true})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"embeds": [{"title": "hi"}]}`, string(payload))
+}
+
+func TestSlackFormatter(t *testing.T) {
+	payload, err := new(SlackFormatter).Format(FromPost(testPost))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"text": "New post by u/testuser: Test <post>",
+		"blocks": [
+			{"type": "section", "text": {"type": "mrkdwn", "text": "*<https://www.reddit.com/r/test/comments/hw6l6a/test_post/|Test &lt;post&gt;>*"}},
+			{"type": "section", "text": {"type": "mrkdwn", "text": "Some *bold* text and <https://example.com|a link>."}},
+			{"type": "context", "elements": [{"type": "mrkdwn", "text": "u/testuser in r/test"}]}
+		]
+	}`, string(payload))
+}
+
+func TestSend(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &received))
+
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message": "Invalid payload"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	err := Send(ctx, server.Client(), server.URL, new(DiscordFormatter), nil)
+	require.EqualError(t, err, "*Notification: cannot be nil")
+
+	err = Send(ctx, server.Client(), server.URL, new(DiscordFormatter), FromPost(testPost))
+	require.NoError(t, err)
+	require.Len(t, received["embeds"], 1)
+
+	err = Send(ctx, server.Client(), server.URL+"/fail", new(SlackFormatter), FromPost(testPost))
+	require.EqualError(t, err, `webhook responded with 400: {"message": "Invalid payload"}`)
+}
@@ -0,0 +1,92 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Limits that Discord puts on embeds.
+const (
+	discordMaxTitle       = 256
+	discordMaxDescription = 4096
+)
+
+// DiscordFormatter formats notifications for Discord webhooks, as a message with one embed.
+// Discord renders markdown, so the body is kept as is.
+//
+// Discord docs: https://discord.com/developers/docs/resources/webhook#execute-webhook
+type DiscordFormatter struct {
+	// Optional, overrides the webhook's default username.
+	Username string
+	// Optional, the color of the embed's left border, e.g. 0xFF4500.
+	Color int
+	// Optional, the most runes of the body to include. If 0 or more than Discord allows,
+	// Discord's limit is used.
+	MaxBodyLength int
+}
+
+type discordPayload struct {
+	Username string          `json:"username,omitempty"`
+	Embeds   []*discordEmbed `json:"embeds"`
+}
+
+type discordEmbed struct {
+	Title       string         `json:"title,omitempty"`
+	Description string         `json:"description,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	Color       int            `json:"color,omitempty"`
+	Timestamp   string         `json:"timestamp,omitempty"`
+	Author      *discordAuthor `json:"author,omitempty"`
+	Footer      *discordFooter `json:"footer,omitempty"`
+}
+
+type discordAuthor struct {
+	Name string `json:"name"`
+}
+
+type discordFooter struct {
+	Text string `json:"text"`
+}
+
+// Format implements the Formatter interface.
+func (f *DiscordFormatter) Format(n *Notification) ([]byte, error) {
+	max := f.MaxBodyLength
+	if max <= 0 || max > discordMaxDescription {
+		max = discordMaxDescription
+	}
+
+	embed := &discordEmbed{
+		Title: truncate(n.Title, discordMaxTitle),
+		URL:   n.URL,
+		Color: f.Color,
+	}
+	if n.Spoiler && n.Body != "" {
+		// || hides text as a spoiler until it's clicked. The tokens count towards the limit,
+		// but at least one character of the body is kept.
+		length := max - 4
+		if length < 1 {
+			length = 1
+		}
+		embed.Description = "||" + truncate(n.Body, length) + "||"
+	} else {
+		embed.Description = truncate(n.Body, max)
+	}
+	if n.NSFW {
+		embed.Title = truncate("[NSFW] "+n.Title, discordMaxTitle)
+	}
+	if n.Author != "" {
+		embed.Author = &discordAuthor{Name: "u/" + n.Author}
+	}
+	if n.Subreddit != "" {
+		embed.Footer = &discordFooter{Text: fmt.Sprintf("r/%s · %s", n.Subreddit, n.Kind)}
+	}
+	if !n.Created.IsZero() {
+		embed.Timestamp = n.Created.UTC().Format(time.RFC3339)
+	}
+
+	return json.Marshal(&discordPayload{
+		Username: f.Username,
+		Embeds:   []*discordEmbed{embed},
+	})
+}
@@ -0,0 +1,101 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// slackMaxText is the most characters Slack allows in a section block's text.
+const slackMaxText = 3000
+
+// SlackFormatter formats notifications for Slack incoming webhooks, as a message with
+// Block Kit sections. Slack's mrkdwn differs from Reddit's markdown, so bold text and
+// links in the body are converted, and everything else is escaped.
+//
+// Slack docs: https://api.slack.com/messaging/webhooks
+type SlackFormatter struct {
+	// Optional, the most characters of the body to include. If 0 or more than Slack allows,
+	// Slack's limit is used.
+	MaxBodyLength int
+}
+
+type slackPayload struct {
+	// Shown in notifications, and by clients that can't show blocks.
+	Text   string        `json:"text"`
+	Blocks []*slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type     string       `json:"type"`
+	Text     *slackText   `json:"text,omitempty"`
+	Elements []*slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+var (
+	markdownBoldRegexp = regexp.MustCompile(`\*\*(.+?)\*\*`)
+	markdownLinkRegexp = regexp.MustCompile(`\[([^\]]+)\]\((https?://[^)\s]+)\)`)
+)
+
+// slackEscaper escapes the characters Slack gives special meaning to.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// toSlackMarkdown converts Reddit markdown to Slack's mrkdwn.
+func toSlackMarkdown(s string) string {
+	s = slackEscaper.Replace(s)
+	s = markdownLinkRegexp.ReplaceAllString(s, "<$2|$1>")
+	s = markdownBoldRegexp.ReplaceAllString(s, "*$1*")
+	return s
+}
+
+// Format implements the Formatter interface.
+func (f *SlackFormatter) Format(n *Notification) ([]byte, error) {
+	max := f.MaxBodyLength
+	if max <= 0 || max > slackMaxText {
+		max = slackMaxText
+	}
+
+	title := slackEscaper.Replace(n.Title)
+	if n.URL != "" {
+		title = fmt.Sprintf("<%s|%s>", n.URL, title)
+	}
+	if n.NSFW {
+		title = "[NSFW] " + title
+	}
+
+	payload := &slackPayload{
+		Text: truncate(fmt.Sprintf("New %s by u/%s: %s", n.Kind, n.Author, n.Title), slackMaxText),
+		Blocks: []*slackBlock{
+			{Type: "section", Text: &slackText{Type: "mrkdwn", Text: truncate("*"+title+"*", slackMaxText)}},
+		},
+	}
+
+	body := n.Body
+	if n.Spoiler {
+		// Slack has no spoiler formatting, so the body is left out.
+		body = "_Spoiler hidden._"
+	}
+	if body != "" {
+		payload.Blocks = append(payload.Blocks, &slackBlock{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: truncate(toSlackMarkdown(body), max)},
+		})
+	}
+
+	context := fmt.Sprintf("u/%s", n.Author)
+	if n.Subreddit != "" {
+		context += fmt.Sprintf(" in r/%s", n.Subreddit)
+	}
+	payload.Blocks = append(payload.Blocks, &slackBlock{
+		Type:     "context",
+		Elements: []*slackText{{Type: "mrkdwn", Text: slackEscaper.Replace(context)}},
+	})
+
+	return json.Marshal(payload)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+)
+
+var ctx = context.Background()
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() (err error) {
+	// Reads the credentials from the GO_REDDIT_CLIENT_* environment variables.
+	client, err := reddit.NewClient(reddit.Credentials{}, reddit.FromEnv)
+	if err != nil {
+		return
+	}
+
+	posts, _, _, err := client.Moderation.ListQueue(ctx, "test", "modqueue", &reddit.ListModQueueOptions{Only: "links"})
+	if err != nil {
+		return
+	}
+
+	for _, post := range posts {
+		ok, _, err := client.User.MeetsThreshold(ctx, post.Author, 100, time.Hour*24*30)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			if _, err = client.Moderation.Approve(ctx, post.FullID); err != nil {
+				return err
+			}
+			fmt.Printf("Approved %s by u/%s\n", post.FullID, post.Author)
+		} else {
+			if _, err = client.Moderation.Remove(ctx, post.FullID); err != nil {
+				return err
+			}
+			fmt.Printf("Removed %s by u/%s\n", post.FullID, post.Author)
+		}
+	}
+
+	return
+}
@@ -244,6 +244,54 @@ func (s *AccountService) Karma(ctx context.Context) ([]*SubredditKarma, *Respons
 	return karma, resp, nil
 }
 
+// NeedsCaptcha returns whether you need to solve a captcha to submit posts and send messages,
+// which can be the case for new accounts. Get one to solve with NewCaptcha.
+func (s *AccountService) NeedsCaptcha(ctx context.Context) (bool, *Response, error) {
+	path := "api/needs_captcha"
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	root := new(bool)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return *root, resp, nil
+}
+
+// NewCaptcha returns the iden of a new captcha. Its image is at
+// https://www.reddit.com/captcha/{iden}.png; send the iden and the answer to it in the
+// Iden and Captcha fields of the submit or send request that needs it.
+func (s *AccountService) NewCaptcha(ctx context.Context) (string, *Response, error) {
+	path := "api/new_captcha"
+
+	form := url.Values{}
+	form.Set("api_type", "json")
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return "", nil, err
+	}
+
+	root := new(struct {
+		JSON struct {
+			Data struct {
+				Iden string `json:"iden"`
+			} `json:"data"`
+		} `json:"json"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return root.JSON.Data.Iden, resp, nil
+}
+
 // Settings returns your account settings.
 func (s *AccountService) Settings(ctx context.Context) (*Settings, *Response, error) {
 	path := "api/v1/me/prefs"
@@ -309,6 +357,45 @@ func (s *AccountService) Friends(ctx context.Context) ([]Relationship, *Response
 	return root[0].Data.Relationships, resp, nil
 }
 
+// ListFriends returns your friends, one page at a time.
+func (s *AccountService) ListFriends(ctx context.Context, opts *ListRelationshipOptions) ([]Relationship, *Response, error) {
+	path := "prefs/friends"
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root [2]struct {
+		Data struct {
+			Relationships []Relationship `json:"children"`
+			After         string         `json:"after"`
+		} `json:"data"`
+	}
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.After = root[0].Data.After
+
+	friends := root[0].Data.Relationships
+	if opts != nil && opts.ExcludeDeleted {
+		friends = friends[:0]
+		for _, friend := range root[0].Data.Relationships {
+			if friend.UserStatus() != RelationshipUserDeleted {
+				friends = append(friends, friend)
+			}
+		}
+	}
+
+	return friends, resp, nil
+}
+
 // Blocked returns a list of your blocked users.
 func (s *AccountService) Blocked(ctx context.Context) ([]Relationship, *Response, error) {
 	path := "prefs/blocked"
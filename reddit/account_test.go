@@ -171,6 +171,40 @@ func TestAccountService_Karma(t *testing.T) {
 	require.Equal(t, expectedKarma, karma)
 }
 
+func TestAccountService_NeedsCaptcha(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/needs_captcha", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `true`)
+	})
+
+	needsCaptcha, _, err := client.Account.NeedsCaptcha(ctx)
+	require.NoError(t, err)
+	require.True(t, needsCaptcha)
+}
+
+func TestAccountService_NewCaptcha(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/new_captcha", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, `{"json": {"errors": [], "data": {"iden": "Xc2GJxmjUS7OBzGqAKZrxFTy6cCSv3Qh"}}}`)
+	})
+
+	iden, _, err := client.Account.NewCaptcha(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Xc2GJxmjUS7OBzGqAKZrxFTy6cCSv3Qh", iden)
+}
+
 func TestAccountService_Settings(t *testing.T) {
 	client, mux := setup(t)
 
@@ -243,6 +277,33 @@ func TestAccountService_Friends(t *testing.T) {
 	require.Equal(t, expectedRelationships, relationships)
 }
 
+func TestAccountService_ListFriends(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/account/friends.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/prefs/friends", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "2")
+		form.Set("after", "r9_abc")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	relationships, _, err := client.Account.ListFriends(ctx, &ListRelationshipOptions{
+		ListOptions: ListOptions{Limit: 2, After: "r9_abc"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRelationships, relationships)
+}
+
 func TestAccountService_Blocked(t *testing.T) {
 	client, mux := setup(t)
 
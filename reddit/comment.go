@@ -3,6 +3,7 @@ package reddit
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -17,16 +18,90 @@ type CommentService struct {
 	client *Client
 }
 
+// ReplyGuard keeps CommentService.Submit from replying where replies from bots tend to be
+// unwelcome. Configure it with WithReplyGuard.
+type ReplyGuard struct {
+	// Refuse replies deeper than this in a comment thread, where a top-level comment has a
+	// depth of 1, a reply to it a depth of 2, and so on. If 0 or less, there is no limit.
+	MaxDepth int
+	// Refuse replies in locked posts, and to locked comments.
+	RefuseLocked bool
+	// Refuse replies in archived posts.
+	RefuseArchived bool
+}
+
+// checkReplyGuard returns an error if the client's reply guard forbids replying to the parent.
+// Making sure costs a request for each comment up the thread, plus one for the post.
+func (s *CommentService) checkReplyGuard(ctx context.Context, parentID string) (*Response, error) {
+	guard := s.client.replyGuard
+	if guard == nil {
+		return nil, nil
+	}
+
+	postID := parentID
+	depth := 1
+	for id := parentID; strings.HasPrefix(id, kindComment+"_"); {
+		_, comments, _, resp, err := s.client.Listings.Get(ctx, id)
+		if err != nil {
+			return resp, err
+		}
+		if len(comments) == 0 {
+			return resp, fmt.Errorf("comment %s: not found", id)
+		}
+		comment := comments[0]
+
+		if guard.RefuseLocked && comment.Locked && id == parentID {
+			return resp, ErrThreadLocked
+		}
+
+		depth++
+		if guard.MaxDepth > 0 && depth > guard.MaxDepth {
+			return resp, ErrReplyTooDeep
+		}
+
+		postID = comment.PostID
+		if guard.MaxDepth <= 0 {
+			break
+		}
+		id = comment.ParentID
+	}
+
+	if !strings.HasPrefix(postID, kindPost+"_") || !(guard.RefuseLocked || guard.RefuseArchived) {
+		return nil, nil
+	}
+
+	posts, _, _, resp, err := s.client.Listings.Get(ctx, postID)
+	if err != nil {
+		return resp, err
+	}
+	if len(posts) == 0 {
+		return resp, fmt.Errorf("post %s: not found", postID)
+	}
+
+	switch post := posts[0]; {
+	case guard.RefuseLocked && post.Locked:
+		return resp, ErrThreadLocked
+	case guard.RefuseArchived && post.Archived:
+		return resp, ErrThreadArchived
+	}
+
+	return nil, nil
+}
+
 // Submit a comment as a reply to a post, comment, or message.
 // parentID is the full ID of the thing being replied to.
 func (s *CommentService) Submit(ctx context.Context, parentID string, text string) (*Comment, *Response, error) {
+	if resp, err := s.checkReplyGuard(ctx, parentID); err != nil {
+		return nil, resp, err
+	}
+
 	path := "api/comment"
 
 	form := url.Values{}
 	form.Set("api_type", "json")
 	form.Set("return_rtjson", "true")
 	form.Set("parent", parentID)
-	form.Set("text", text)
+	form.Set("text", s.client.withFooter(ctx, text))
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -66,6 +141,33 @@ func (s *CommentService) Edit(ctx context.Context, id string, text string) (*Com
 	return root, resp, nil
 }
 
+// Collapse the comment for everyone viewing its thread, as a moderator.
+// The comment and its replies are folded until a reader expands them.
+func (s *CommentService) Collapse(ctx context.Context, id string) (*Response, error) {
+	return s.setCollapseState(ctx, id, true)
+}
+
+// Uncollapse a comment that was collapsed by a moderator.
+func (s *CommentService) Uncollapse(ctx context.Context, id string) (*Response, error) {
+	return s.setCollapseState(ctx, id, false)
+}
+
+func (s *CommentService) setCollapseState(ctx context.Context, id string, collapsed bool) (*Response, error) {
+	path := "api/set_collapse_state"
+
+	form := url.Values{}
+	form.Set("api_type", "json")
+	form.Set("id", id)
+	form.Set("state", fmt.Sprint(collapsed))
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // LoadMoreReplies retrieves more replies that were left out when initially fetching the comment.
 func (s *CommentService) LoadMoreReplies(ctx context.Context, comment *Comment) (*Response, error) {
 	if comment == nil {
@@ -1,6 +1,7 @@
 package reddit
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -21,6 +22,11 @@ var expectedCommentSubmitOrEdit = &Comment{
 	AuthorID:        "t2_user1",
 	AuthorFlairText: "Flair",
 	AuthorFlairID:   "024b2b66-05ca-11e1-96f4-12313d096aae",
+	AuthorFlairType: "richtext",
+	AuthorFlairRichText: []map[string]string{
+		{"e": "text", "t": "Beginner - Strength"},
+	},
+	AuthorFlairTextColor: "dark",
 
 	SubredditName:         "subreddit",
 	SubredditNamePrefixed: "r/subreddit",
@@ -32,7 +38,6 @@ var expectedCommentSubmitOrEdit = &Comment{
 	Controversiality: 0,
 
 	Created: &Timestamp{time.Date(2020, 4, 29, 0, 9, 47, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	PostID: "t3_link1",
 }
@@ -87,11 +92,95 @@ func TestCommentService_SubmitAsync(t *testing.T) {
 	})
 
 	comment, resp, err := client.Comment.Submit(ctx, "t3_1er3vmw", "test comment1")
-	fmt.Println("job_id:", resp.JobId)
 	require.NoError(t, err)
+	fmt.Println("job_id:", resp.JobId)
 	require.Equal(t, expectedCommentSubmitOrEdit, comment)
 }
 
+func TestCommentService_Submit_DisclosureFooter(t *testing.T) {
+	client, mux := setup(t)
+	err := WithDisclosureFooter("^(I am a bot)")(client)
+	require.NoError(t, err)
+
+	blob, err := readFileContents("../testdata/comment/submit-or-edit.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("return_rtjson", "true")
+		form.Set("parent", "t1_test")
+		form.Set("text", "test comment\n\n^(I am a bot)")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Comment.Submit(ctx, "t1_test", "test comment")
+	require.NoError(t, err)
+
+	// the footer isn't added twice
+	_, _, err = client.Comment.Submit(ctx, "t1_test", "test comment\n\n^(I am a bot)")
+	require.NoError(t, err)
+}
+
+func TestCommentService_Submit_ReplyGuard(t *testing.T) {
+	client, mux := setup(t)
+	err := WithReplyGuard(ReplyGuard{MaxDepth: 2, RefuseLocked: true, RefuseArchived: true})(client)
+	require.NoError(t, err)
+
+	things := map[string]string{
+		"t1_c1": `{"kind": "t1", "data": {"name": "t1_c1", "parent_id": "t3_p1", "link_id": "t3_p1"}}`,
+		"t1_c2": `{"kind": "t1", "data": {"name": "t1_c2", "parent_id": "t1_c1", "link_id": "t3_p1"}}`,
+		"t1_c3": `{"kind": "t1", "data": {"name": "t1_c3", "parent_id": "t3_p1", "link_id": "t3_p1", "locked": true}}`,
+		"t3_p1": `{"kind": "t3", "data": {"name": "t3_p1"}}`,
+		"t3_p2": `{"kind": "t3", "data": {"name": "t3_p2", "archived": true}}`,
+	}
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		thing, ok := things[r.Form.Get("id")]
+		require.True(t, ok)
+		fmt.Fprintf(w, `{"kind": "Listing", "data": {"children": [%s]}}`, thing)
+	})
+
+	var submitted []string
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		submitted = append(submitted, r.PostForm.Get("parent"))
+
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err = client.Comment.Submit(ctx, "t3_p1", "test comment")
+	require.NoError(t, err)
+
+	_, _, err = client.Comment.Submit(ctx, "t1_c1", "test comment")
+	require.NoError(t, err)
+
+	_, _, err = client.Comment.Submit(ctx, "t1_c2", "test comment")
+	require.Equal(t, ErrReplyTooDeep, err)
+
+	_, _, err = client.Comment.Submit(ctx, "t1_c3", "test comment")
+	require.Equal(t, ErrThreadLocked, err)
+
+	_, _, err = client.Comment.Submit(ctx, "t3_p2", "test comment")
+	require.Equal(t, ErrThreadArchived, err)
+
+	require.Equal(t, []string{"t3_p1", "t1_c1"}, submitted)
+}
+
 func TestCommentService_Edit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -254,6 +343,48 @@ func TestCommentService_Unlock(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestCommentService_Collapse(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/set_collapse_state", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("id", "t1_test")
+		form.Set("state", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	resp, err := client.Comment.Collapse(ctx, "t1_test")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestCommentService_Uncollapse(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/set_collapse_state", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("id", "t1_test")
+		form.Set("state", "false")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	resp, err := client.Comment.Uncollapse(ctx, "t1_test")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestCommentService_Upvote(t *testing.T) {
 	client, mux := setup(t)
 
@@ -381,3 +512,96 @@ func TestCommentService_Report(t *testing.T) {
 	_, err := client.Comment.Report(ctx, "t1_test", "test reason")
 	require.NoError(t, err)
 }
+
+func TestComment_UnmarshalJSON_Removal(t *testing.T) {
+	comment := new(Comment)
+	err := json.Unmarshal([]byte(`{
+		"id": "test",
+		"banned_by": "modusername",
+		"mod_reason_by": "othermod",
+		"removal_reason": "spam"
+	}`), comment)
+	require.NoError(t, err)
+	require.Equal(t, "test", comment.ID)
+	require.Equal(t, &Removal{By: "modusername", Category: "moderator", Reason: "spam"}, comment.Removal)
+}
+
+func TestComment_UnmarshalJSON_Distinguished(t *testing.T) {
+	comment := new(Comment)
+	err := json.Unmarshal([]byte(`{
+		"id": "test",
+		"distinguished": "moderator",
+		"stickied": true,
+		"depth": 2,
+		"collapsed": true,
+		"collapsed_reason": "comment score below threshold",
+		"author_flair_type": "richtext",
+		"author_flair_richtext": [{"e": "emoji", "a": ":snoo:", "u": "https://emoji.redditmedia.com/snoo.png"}]
+	}`), comment)
+	require.NoError(t, err)
+	require.Equal(t, "moderator", comment.Distinguished)
+	require.True(t, comment.Stickied)
+	require.Equal(t, 2, comment.Depth)
+	require.True(t, comment.Collapsed)
+	require.Equal(t, "comment score below threshold", comment.CollapsedReason)
+	require.Equal(t, "richtext", comment.AuthorFlairType)
+	require.Equal(t, ":snoo:", RichTextFlairString(comment.AuthorFlairRichText))
+}
+
+func TestComment_MediaMetadata(t *testing.T) {
+	comment := new(Comment)
+	err := json.Unmarshal([]byte(`{
+		"id": "test",
+		"body": "nice ![gif](giphy|abc123|downsized) ![img](emote|t5_2qh1i|1234) ![img](img456) ![img](missing)",
+		"media_metadata": {
+			"giphy|abc123": {
+				"status": "valid",
+				"e": "AnimatedImage",
+				"m": "image/gif",
+				"ext": "https://giphy.com/gifs/abc123",
+				"s": {"y": 200, "x": 300, "gif": "https://giphy.com/media/abc123/giphy.gif", "mp4": "https://giphy.com/media/abc123/giphy.mp4"},
+				"t": "giphy",
+				"id": "giphy|abc123"
+			},
+			"emote|t5_2qh1i|1234": {
+				"status": "valid",
+				"e": "Image",
+				"m": "image/png",
+				"s": {"y": 20, "x": 20, "u": "https://reddit-econ-prod-assets-permanent.s3.amazonaws.com/asset-manager/t5_2qh1i/emote.png"},
+				"t": "sticker",
+				"id": "emote|t5_2qh1i|1234"
+			},
+			"img456": {
+				"status": "valid",
+				"e": "Image",
+				"m": "image/jpg",
+				"p": [{"y": 108, "x": 108, "u": "https://preview.redd.it/img456.jpg?width=108"}],
+				"s": {"y": 512, "x": 512, "u": "https://preview.redd.it/img456.jpg"},
+				"id": "img456"
+			}
+		}
+	}`), comment)
+	require.NoError(t, err)
+	require.Len(t, comment.MediaMetadata, 3)
+
+	gif := comment.MediaMetadata["giphy|abc123"]
+	require.Equal(t, MediaKindGIF, gif.Kind)
+	require.Equal(t, "https://giphy.com/media/abc123/giphy.gif", gif.URL())
+	require.Equal(t, "https://giphy.com/media/abc123/giphy.mp4", gif.Source.MP4)
+	require.Equal(t, "https://giphy.com/gifs/abc123", gif.ExternalURL)
+
+	require.Equal(t, MediaKindEmote, comment.MediaMetadata["emote|t5_2qh1i|1234"].Kind)
+
+	img := comment.MediaMetadata["img456"]
+	require.Equal(t, MediaKindImage, img.Kind)
+	require.Equal(t, &MediaSource{Width: 512, Height: 512, URL: "https://preview.redd.it/img456.jpg"}, img.Source)
+	require.Len(t, img.Previews, 1)
+
+	body := comment.ReplaceMedia(func(media *MediaMetadata) string {
+		if media.Kind == MediaKindEmote {
+			return ""
+		}
+		return media.URL()
+	})
+	require.Equal(t, "nice https://giphy.com/media/abc123/giphy.gif  https://preview.redd.it/img456.jpg ![img](missing)", body)
+}
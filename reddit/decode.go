@@ -0,0 +1,54 @@
+package reddit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var errNoPost = errors.New("response contains no post")
+
+// Listing is a page of things, as decoded by DecodeListing.
+type Listing struct {
+	Comments          []*Comment
+	Mores             []*More
+	Users             []*User
+	Posts             []*Post
+	Subreddits        []*Subreddit
+	ModActions        []*ModAction
+	Multis            []*Multi
+	LiveThreads       []*LiveThread
+	LiveThreadUpdates []*LiveThreadUpdate
+
+	// The anchor to use to get the next page, if there is one.
+	After string
+}
+
+// DecodeListing decodes a listing in the format the Reddit API returns it in, e.g. the body of
+// a response from r/{subreddit}/new, or a line of an archive of such responses. It decodes it
+// the same way the client does, and returns an error if the payload is malformed or isn't what
+// was expected.
+func DecodeListing(data []byte) (*Listing, error) {
+	root := new(thing)
+	if err := json.Unmarshal(data, root); err != nil {
+		return nil, err
+	}
+
+	l, ok := root.Listing()
+	if !ok {
+		return nil, fmt.Errorf("expected a listing, got kind %q", root.Kind)
+	}
+
+	return &Listing{
+		Comments:          l.things.Comments,
+		Mores:             l.things.Mores,
+		Users:             l.things.Users,
+		Posts:             l.things.Posts,
+		Subreddits:        l.things.Subreddits,
+		ModActions:        l.things.ModActions,
+		Multis:            l.things.Multis,
+		LiveThreads:       l.things.LiveThreads,
+		LiveThreadUpdates: l.things.LiveThreadUpdates,
+		After:             l.after,
+	}, nil
+}
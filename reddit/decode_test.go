@@ -0,0 +1,75 @@
+package reddit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeListing_Malformed(t *testing.T) {
+	tests := map[string]string{
+		"replies not a listing":  `{"kind": "Listing", "data": {"children": [{"kind": "t1", "data": {"replies": {"kind": "t3", "data": {}}}}]}}`,
+		"replies without data":   `{"kind": "Listing", "data": {"children": [{"kind": "t1", "data": {"replies": {}}}]}}`,
+		"children without kind":  `{"kind": "Listing", "data": {"children": [{}, {"data": {}}]}}`,
+		"listing without data":   `{"kind": "Listing"}`,
+		"listing with null data": `{"kind": "Listing", "data": null}`,
+		"post with wrong types":  `{"kind": "Listing", "data": {"children": [{"kind": "t3", "data": {"created_utc": "2020-01-01", "banned_by": true}}]}}`,
+	}
+
+	for name, payload := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.NotPanics(t, func() {
+				l, err := DecodeListing([]byte(payload))
+				if err != nil {
+					return
+				}
+				for _, comment := range l.Comments {
+					comment.HasMore()
+				}
+			})
+		})
+	}
+}
+
+func TestPostAndComments_UnmarshalJSON_Malformed(t *testing.T) {
+	tests := map[string]string{
+		"no listings":          `[{}, {}]`,
+		"second not a listing": `[{"kind": "Listing", "data": {"children": [{"kind": "t3", "data": {}}]}}, {"kind": "t3", "data": {}}]`,
+		"first not a listing":  `[{"kind": "t3", "data": {}}, {"kind": "Listing", "data": {}}]`,
+	}
+
+	for name, payload := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.NotPanics(t, func() {
+				pc := new(PostAndComments)
+				if json.Unmarshal([]byte(payload), pc) != nil {
+					return
+				}
+				require.NotNil(t, pc.Post)
+				pc.HasMore()
+			})
+		})
+	}
+}
+
+func TestDecodeListing(t *testing.T) {
+	blob, err := readFileContents("../testdata/listings/posts.json")
+	require.NoError(t, err)
+
+	l, err := DecodeListing([]byte(blob))
+	require.NoError(t, err)
+	require.Len(t, l.Posts, 2)
+	require.Empty(t, l.After)
+
+	_, err = DecodeListing([]byte(`{"kind": "t3", "data": {}}`))
+	require.EqualError(t, err, `expected a listing, got kind "t3"`)
+
+	_, err = DecodeListing([]byte(`{"kind": "Listing", "data": {"children": [{"kind": "t1", "data": {"replies": 1}}]}}`))
+	require.Error(t, err)
+}
+
+func TestPostAndComments_UnmarshalJSON_NoPost(t *testing.T) {
+	err := json.Unmarshal([]byte(`[{"kind": "Listing", "data": {"children": []}}, {"kind": "Listing", "data": {}}]`), new(PostAndComments))
+	require.Equal(t, errNoPost, err)
+}
@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -22,6 +23,101 @@ const (
 	ErrorRateLimitCode                 = 10004
 )
 
+// ErrCredentialsInvalid is returned by a client created with WithMaxAuthFailures once Reddit
+// has rejected its credentials too many times in a row, e.g. because the app was revoked or the
+// account suspended. After that, the client stops sending requests and returns this error
+// straight away, until Client.ResetAuthFailures or Client.InitializeAccessToken is called.
+var ErrCredentialsInvalid = errors.New("reddit: credentials are invalid, the client has stopped making requests")
+
+// ErrSuspended is returned for every request made with a client that has been suspended with
+// Client.Suspend, until it's resumed with Client.Resume.
+var ErrSuspended = errors.New("reddit: client is suspended, it isn't making requests")
+
+// Errors returned by CommentService.Submit when the client's ReplyGuard refuses a reply.
+var (
+	ErrReplyTooDeep   = errors.New("reddit: reply would be deeper than the reply guard allows")
+	ErrThreadLocked   = errors.New("reddit: reply guard refuses to reply in a locked thread")
+	ErrThreadArchived = errors.New("reddit: reply guard refuses to reply in an archived thread")
+)
+
+// ErrNotFound is the error of an item of ListingsService.GetBatch that Reddit returned nothing
+// for, e.g. because it doesn't exist or isn't visible to the client.
+var ErrNotFound = errors.New("reddit: not found")
+
+// ErrUndoUnsupported is returned by ModerationService.Undo for mod actions it cannot reverse.
+var ErrUndoUnsupported = errors.New("reddit: mod action cannot be undone")
+
+// ErrQuarantined is matched, using errors.Is, by the error returned when accessing a quarantined
+// subreddit, or a post or comment in one, without having opted in to seeing it.
+// See SubredditService.OptInQuarantine.
+var ErrQuarantined = errors.New("reddit: subreddit is quarantined")
+
+// QuarantinedError is returned when accessing a quarantined subreddit, or a post or comment in
+// one, without having opted in to seeing it.
+type QuarantinedError struct {
+	// HTTP response that caused this error.
+	Response *http.Response `json:"-"`
+
+	// The subreddit's quarantine notice.
+	Message string `json:"quarantine_message"`
+}
+
+func (e *QuarantinedError) Error() string {
+	return fmt.Sprintf(
+		"%s %s: %d subreddit is quarantined",
+		e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode,
+	)
+}
+
+// Is reports whether target is ErrQuarantined.
+func (e *QuarantinedError) Is(target error) bool {
+	return target == ErrQuarantined
+}
+
+// quarantinedError returns the QuarantinedError for the 403 response r with body data,
+// if it's because of a quarantine.
+func quarantinedError(r *http.Response, data []byte) *QuarantinedError {
+	root := new(struct {
+		Reason string `json:"reason"`
+		QuarantinedError
+	})
+	if err := json.Unmarshal(data, root); err != nil || root.Reason != "quarantined" {
+		return nil
+	}
+
+	root.QuarantinedError.Response = r
+	return &root.QuarantinedError
+}
+
+// FlairRequiredError is returned when submitting a post without flair to a subreddit that
+// requires it, if the client was configured with WithPostFlairRequirementCheck and has no
+// default flair for that subreddit.
+type FlairRequiredError struct {
+	Subreddit string
+	// The post flairs that can be used in the subreddit.
+	Choices []*Flair
+}
+
+func (e *FlairRequiredError) Error() string {
+	return fmt.Sprintf("r/%s requires posts to have flair, choose from %d flairs", e.Subreddit, len(e.Choices))
+}
+
+// WikiEditConflictError is returned when editing a wiki page that was revised after the
+// revision the edit was based on, i.e. the edit's previous revision.
+type WikiEditConflictError struct {
+	Subreddit string `json:"-"`
+	Page      string `json:"-"`
+	// The page's current content and revision.
+	NewContent    string `json:"newcontent"`
+	NewRevisionID string `json:"newrevision"`
+	// The difference between the edit and the page's current content, as HTML.
+	Diff string `json:"diffcontent"`
+}
+
+func (e *WikiEditConflictError) Error() string {
+	return fmt.Sprintf("r/%s wiki page %q was edited by someone else, it is now at revision %s", e.Subreddit, e.Page, e.NewRevisionID)
+}
+
 // APIError is an error coming from Reddit.
 type APIError struct {
 	Label  string
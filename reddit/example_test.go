@@ -0,0 +1,181 @@
+package reddit_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+)
+
+var ctx = context.Background()
+
+func ExampleNewClient() {
+	credentials := reddit.Credentials{ID: "id", Secret: "secret", Username: "username", Password: "password"}
+	client, err := reddit.NewClient(credentials, reddit.WithUserAgent("my-bot/1.0 by username"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	user, _, err := client.User.Get(ctx, "username")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s has %d karma\n", user.Name, user.PostKarma+user.CommentKarma)
+}
+
+func ExampleNewClient_fromEnv() {
+	// Reads GO_REDDIT_CLIENT_ID, GO_REDDIT_CLIENT_SECRET, GO_REDDIT_CLIENT_USERNAME
+	// and GO_REDDIT_CLIENT_PASSWORD.
+	client, err := reddit.NewClient(reddit.Credentials{}, reddit.FromEnv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, _, err = client.Account.Info(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func ExampleNewReadonlyClient() {
+	client, err := reddit.NewReadonlyClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sr, _, err := client.Subreddit.Get(ctx, "golang")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s has %d subscribers\n", sr.NamePrefixed, sr.Subscribers)
+}
+
+func ExampleSubredditService_TopPosts() {
+	opts := &reddit.ListPostOptions{
+		ListOptions: reddit.ListOptions{Limit: 100},
+		Time:        "week",
+	}
+
+	// Get the first 3 pages of this week's top posts.
+	for page := 0; page < 3; page++ {
+		posts, resp, err := reddit.DefaultClient().Subreddit.TopPosts(ctx, "golang", opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, post := range posts {
+			fmt.Println(post.Title)
+		}
+
+		if resp.After == "" {
+			break
+		}
+		opts.After = resp.After
+	}
+}
+
+func ExampleStreamService_Posts() {
+	posts, errs, stop := reddit.DefaultClient().Stream.Posts("AskReddit", reddit.StreamInterval(time.Second*3), reddit.StreamDiscardInitial)
+	defer stop()
+
+	timer := time.NewTimer(time.Minute)
+	defer timer.Stop()
+
+	for {
+		select {
+		case post, ok := <-posts:
+			if !ok {
+				return
+			}
+			fmt.Printf("Received post: %s\n", post.Title)
+		case err, ok := <-errs:
+			if !ok {
+				return
+			}
+			log.Println(err)
+		case <-timer.C:
+			return
+		}
+	}
+}
+
+func ExamplePostService_SubmitText() {
+	client, err := reddit.NewClient(reddit.Credentials{}, reddit.FromEnv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	post, _, err := client.Post.SubmitText(ctx, reddit.SubmitTextRequest{
+		Subreddit: "test",
+		Title:     "This is a title",
+		Text:      "This is some text",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("The text post is available at: %s\n", post.URL)
+}
+
+func ExampleCommentService_Submit() {
+	client, err := reddit.NewClient(reddit.Credentials{}, reddit.FromEnv,
+		reddit.WithReplyGuard(reddit.ReplyGuard{MaxDepth: 5, RefuseLocked: true, RefuseArchived: true}),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	comment, _, err := client.Comment.Submit(ctx, "t3_abc123", "Thanks for sharing!")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Replied with comment %s\n", comment.FullID)
+}
+
+func ExampleModerationService_ListQueue() {
+	client, err := reddit.NewClient(reddit.Credentials{}, reddit.FromEnv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	posts, _, _, err := client.Moderation.ListQueue(ctx, "mysubreddit", "modqueue", &reddit.ListModQueueOptions{Only: "links"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Approve posts from users with enough karma, and remove the rest.
+	for _, post := range posts {
+		ok, _, err := client.User.MeetsThreshold(ctx, post.Author, 100, time.Hour*24*30)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if ok {
+			_, err = client.Moderation.Approve(ctx, post.FullID)
+		} else {
+			_, err = client.Moderation.Remove(ctx, post.FullID)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func ExampleModerationService_Undo() {
+	client, err := reddit.NewClient(reddit.Credentials{}, reddit.FromEnv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	actions, _, err := client.Moderation.Actions(ctx, "mysubreddit", &reddit.ListModActionOptions{Moderator: "misbehaving-bot"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Roll back everything the bot did.
+	for _, action := range actions {
+		if _, err := client.Moderation.Undo(ctx, action); err != nil {
+			log.Println(err)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
@@ -32,6 +34,13 @@ type Flair struct {
 
 	Editable bool `json:"text_editable"`
 	ModOnly  bool `json:"mod_only"`
+
+	// One of: all, emoji, text.
+	AllowableContent string `json:"allowable_content,omitempty"`
+	MaxEmojis        int    `json:"max_emojis,omitempty"`
+	// The flair's text and emojis, if its type is richtext.
+	RichText    []map[string]string `json:"richtext,omitempty"`
+	OverrideCSS bool                `json:"override_css"`
 }
 
 // FlairSummary is a condensed version of Flair.
@@ -90,6 +99,8 @@ type FlairTemplateCreateOrUpdateRequest struct {
 	// One of: none, transparent, 6-digit rgb hex color, e.g. #AABBCC.
 	BackgroundColor string `url:"background_color,omitempty"`
 	CSSClass        string `url:"css_class,omitempty"`
+	// Use the CSS class instead of the background color and text on old Reddit.
+	OverrideCSS *bool `url:"override_css,omitempty"`
 }
 
 // FlairTemplate is a generic flair structure that can users can use next to their username
@@ -139,6 +150,28 @@ type FlairChangeResponse struct {
 	Errors   map[string]string `json:"errors,omitempty"`
 }
 
+// err returns the reason the change failed, or nil if it succeeded.
+func (r *FlairChangeResponse) err() error {
+	if r.OK {
+		return nil
+	}
+	if len(r.Errors) == 0 {
+		return fmt.Errorf("flair change %s", r.Status)
+	}
+
+	fields := make([]string, 0, len(r.Errors))
+	for field := range r.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	reasons := make([]string, len(fields))
+	for i, field := range fields {
+		reasons[i] = fmt.Sprintf("%s: %s", field, r.Errors[field])
+	}
+	return errors.New(strings.Join(reasons, "; "))
+}
+
 // GetUserFlairs returns the user flairs from the subreddit.
 func (s *FlairService) GetUserFlairs(ctx context.Context, subreddit string) ([]*Flair, *Response, error) {
 	path := fmt.Sprintf("r/%s/api/user_flair_v2", subreddit)
@@ -175,10 +208,22 @@ func (s *FlairService) GetPostFlairs(ctx context.Context, subreddit string) ([]*
 	return flairs, resp, nil
 }
 
-// ListUserFlairs returns all flairs of individual users in the subreddit.
+// ListUserFlairs returns the flairs of individual users in the subreddit.
+// It only returns the first page; use ListUserFlairsPage to get the rest.
 func (s *FlairService) ListUserFlairs(ctx context.Context, subreddit string) ([]*FlairSummary, *Response, error) {
+	return s.ListUserFlairsPage(ctx, subreddit, nil)
+}
+
+// ListUserFlairsPage returns a page of the flairs of individual users in the subreddit.
+// If there are more, resp.After can be used as opts.After to get the next page.
+func (s *FlairService) ListUserFlairsPage(ctx context.Context, subreddit string, opts *ListUserFlairOptions) ([]*FlairSummary, *Response, error) {
 	path := fmt.Sprintf("r/%s/api/flairlist", subreddit)
 
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
@@ -186,15 +231,56 @@ func (s *FlairService) ListUserFlairs(ctx context.Context, subreddit string) ([]
 
 	root := new(struct {
 		UserFlairs []*FlairSummary `json:"users"`
+		Next       string          `json:"next"`
 	})
 	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
 		return nil, resp, err
 	}
 
+	resp.After = root.Next
+
 	return root.UserFlairs, resp, nil
 }
 
+// GetConfiguration gets the subreddit's flair settings, from the subreddit's about page.
+// The result can be changed and passed to Configure.
+func (s *FlairService) GetConfiguration(ctx context.Context, subreddit string) (*FlairConfigureRequest, *Response, error) {
+	path := fmt.Sprintf("r/%s/about", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Data struct {
+			UserFlairEnabled           bool   `json:"user_flair_enabled_in_sr"`
+			UserFlairPosition          string `json:"user_flair_position"`
+			UserFlairSelfAssignEnabled bool   `json:"can_assign_user_flair"`
+			PostFlairPosition          string `json:"link_flair_position"`
+			PostFlairSelfAssignEnabled bool   `json:"can_assign_link_flair"`
+		} `json:"data"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	config := &FlairConfigureRequest{
+		UserFlairEnabled:           &root.Data.UserFlairEnabled,
+		UserFlairPosition:          root.Data.UserFlairPosition,
+		UserFlairSelfAssignEnabled: &root.Data.UserFlairSelfAssignEnabled,
+		PostFlairPosition:          root.Data.PostFlairPosition,
+		PostFlairSelfAssignEnabled: &root.Data.PostFlairSelfAssignEnabled,
+	}
+	if config.PostFlairPosition == "" {
+		config.PostFlairPosition = "none"
+	}
+
+	return config, resp, nil
+}
+
 // Configure the subreddit's flair settings.
 func (s *FlairService) Configure(ctx context.Context, subreddit string, request *FlairConfigureRequest) (*Response, error) {
 	if request == nil {
@@ -309,6 +395,33 @@ func (s *FlairService) UpsertPostTemplate(ctx context.Context, subreddit string,
 	return root, resp, nil
 }
 
+// SetUserFlair sets the text and CSS class of the user's flair, without using a template
+// (use Assign for that). If both are empty, the user's flair is cleared.
+// You have to be a moderator of the subreddit for this to work.
+func (s *FlairService) SetUserFlair(ctx context.Context, subreddit string, request *FlairChangeRequest) (*Response, error) {
+	if request == nil {
+		return nil, errors.New("*FlairChangeRequest: cannot be nil")
+	}
+	if request.User == "" {
+		return nil, errors.New("(*FlairChangeRequest).User: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/api/flair", subreddit)
+
+	form := url.Values{}
+	form.Set("api_type", "json")
+	form.Set("name", request.User)
+	form.Set("text", request.Text)
+	form.Set("css_class", request.CSSClass)
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // Delete the flair of the user.
 func (s *FlairService) Delete(ctx context.Context, subreddit, username string) (*Response, error) {
 	path := fmt.Sprintf("r/%s/api/deleteflair", subreddit)
@@ -560,3 +673,45 @@ func (s *FlairService) Change(ctx context.Context, subreddit string, requests []
 
 	return root, resp, nil
 }
+
+// ChangeBatch changes the flair of any number of users in the subreddit, sending them
+// to Reddit 100 at a time. The results are in the same order as the requests, with the
+// username of each request as the result's Input.
+// If a chunk of requests fails as a whole, each of its items gets that error.
+// You have to be a moderator of the subreddit for this to work.
+func (s *FlairService) ChangeBatch(ctx context.Context, subreddit string, requests []FlairChangeRequest) ([]ItemResult, *Response, error) {
+	if len(requests) == 0 {
+		return nil, nil, errors.New("requests: must provide at least 1")
+	}
+
+	results := make([]ItemResult, len(requests))
+	for i, req := range requests {
+		results[i].Input = req.User
+	}
+
+	var resp *Response
+	for start := 0; start < len(requests); start += 100 {
+		end := start + 100
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		changes, chunkResp, err := s.Change(ctx, subreddit, requests[start:end])
+		if chunkResp != nil {
+			resp = chunkResp
+		}
+
+		for i := start; i < end; i++ {
+			switch {
+			case err != nil:
+				results[i].Err = err
+			case i-start >= len(changes):
+				results[i].Err = errors.New("flair change: no result returned")
+			default:
+				results[i].Err = changes[i-start].err()
+			}
+		}
+	}
+
+	return results, resp, nil
+}
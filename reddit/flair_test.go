@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,6 +23,10 @@ var expectedUserFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  false,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
+		RichText:         []map[string]string{},
 	},
 	{
 		ID:   "b8ea0fce-3feb-11e8-af7a-0e263a127cf8",
@@ -34,6 +39,10 @@ var expectedUserFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  true,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
+		RichText:         []map[string]string{},
 	},
 }
 
@@ -49,6 +58,12 @@ var expectedPostFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  true,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
+		RichText: []map[string]string{
+			{"e": "text", "t": "test"},
+		},
 	},
 }
 
@@ -199,6 +214,85 @@ func TestFlairService_ListUserFlairs(t *testing.T) {
 	require.Equal(t, expectedListUserFlairs, userFlairs)
 }
 
+func TestFlairService_ListUserFlairsPage(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/flair/list-user-flairs-page.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/flairlist", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "2")
+		form.Set("after", "t2_before")
+		form.Set("name", "TestUser1")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	userFlairs, resp, err := client.Flair.ListUserFlairsPage(ctx, "testsubreddit", &ListUserFlairOptions{
+		ListOptions: ListOptions{Limit: 2, After: "t2_before"},
+		Username:    "TestUser1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedListUserFlairs, userFlairs)
+	require.Equal(t, "t2_next", resp.After)
+}
+
+func TestFlairService_SetUserFlair(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/flair", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "user1")
+		form.Set("text", "Trusted")
+		form.Set("css_class", "trusted")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Flair.SetUserFlair(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "*FlairChangeRequest: cannot be nil")
+
+	_, err = client.Flair.SetUserFlair(ctx, "testsubreddit", &FlairChangeRequest{Text: "Trusted"})
+	require.EqualError(t, err, "(*FlairChangeRequest).User: cannot be empty")
+
+	_, err = client.Flair.SetUserFlair(ctx, "testsubreddit", &FlairChangeRequest{User: "user1", Text: "Trusted", CSSClass: "trusted"})
+	require.NoError(t, err)
+}
+
+func TestFlairService_GetConfiguration(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/about.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	config, _, err := client.Flair.GetConfiguration(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, &FlairConfigureRequest{
+		UserFlairEnabled:           Bool(false),
+		UserFlairPosition:          "left",
+		UserFlairSelfAssignEnabled: Bool(false),
+		PostFlairPosition:          "left",
+		PostFlairSelfAssignEnabled: Bool(false),
+	}, config)
+}
+
 func TestFlairService_Configure(t *testing.T) {
 	client, mux := setup(t)
 
@@ -334,6 +428,7 @@ func TestFlairService_UpsertPostTemplate(t *testing.T) {
 		form.Set("mod_only", "false")
 		form.Set("background_color", "#fafafa")
 		form.Set("css_class", "testclass")
+		form.Set("override_css", "true")
 
 		err := r.ParseForm()
 		require.NoError(t, err)
@@ -354,6 +449,7 @@ func TestFlairService_UpsertPostTemplate(t *testing.T) {
 		TextEditable:     Bool(true),
 		BackgroundColor:  "#fafafa",
 		CSSClass:         "testclass",
+		OverrideCSS:      Bool(true),
 	})
 	require.NoError(t, err)
 	require.Equal(t, expectedFlairTemplate, flairTemplate)
@@ -699,3 +795,77 @@ testuser4,testtext4,testclass4
 	require.NoError(t, err)
 	require.Equal(t, expectedFlairChanges, changes)
 }
+
+func TestFlairService_ChangeBatch(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/flair/csv-change.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/flaircsv", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Flair.ChangeBatch(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "requests: must provide at least 1")
+
+	results, _, err := client.Flair.ChangeBatch(ctx, "testsubreddit", []FlairChangeRequest{
+		{"testuser1", "testtext1", "testclass1"},
+		{"testuser2", "testtext2", "testclass2"},
+		{"testuser3", "testtext3", "testclass3"},
+		{"testuser4", "testtext4", "testclass4"},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	require.Equal(t, "testuser1", results[0].Input)
+	require.EqualError(t, results[0].Err, "user: unable to resolve user `testuser1', ignoring")
+	for i, result := range results[1:] {
+		require.Equal(t, fmt.Sprintf("testuser%d", i+2), result.Input)
+		require.NoError(t, result.Err)
+	}
+}
+
+func TestFlairService_ChangeBatch_Chunks(t *testing.T) {
+	client, mux := setup(t)
+
+	var counter int
+	mux.HandleFunc("/r/testsubreddit/api/flaircsv", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		defer func() { counter++ }()
+
+		if counter == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		rows := strings.Count(r.PostForm.Get("flair_csv"), "\n")
+		changes := make([]string, rows)
+		for i := range changes {
+			changes[i] = `{"ok": true, "status": "added flair"}`
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(changes, ","))
+	})
+
+	requests := make([]FlairChangeRequest, 150)
+	for i := range requests {
+		requests[i] = FlairChangeRequest{User: fmt.Sprintf("testuser%d", i)}
+	}
+
+	results, _, err := client.Flair.ChangeBatch(ctx, "testsubreddit", requests)
+	require.NoError(t, err)
+	require.Equal(t, 2, counter)
+	require.Len(t, results, 150)
+
+	for i, result := range results {
+		require.Equal(t, fmt.Sprintf("testuser%d", i), result.Input)
+		if i < 100 {
+			require.NoError(t, result.Err)
+		} else {
+			require.Error(t, result.Err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package reddit
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Kinds of things, as used in the prefix of their full IDs, e.g. the t3 of t3_abc123.
+const (
+	KindComment   = kindComment
+	KindUser      = kindUser
+	KindPost      = kindPost
+	KindMessage   = kindMessage
+	KindSubreddit = kindSubreddit
+	KindAward     = kindTrophy
+)
+
+// ParseFullname splits a full ID, e.g. t3_abc123, into its kind (t3) and ID (abc123).
+func ParseFullname(fullname string) (kind, id string, err error) {
+	i := strings.IndexByte(fullname, '_')
+	if i < 0 {
+		return "", "", fmt.Errorf("full ID %q: missing kind prefix", fullname)
+	}
+
+	kind, id = fullname[:i], fullname[i+1:]
+	if len(kind) != 2 || kind[0] != 't' || kind[1] < '1' || kind[1] > '6' {
+		return "", "", fmt.Errorf("full ID %q: unknown kind %q", fullname, kind)
+	}
+	if _, err := ParseID(id); err != nil {
+		return "", "", fmt.Errorf("full ID %q: %w", fullname, err)
+	}
+
+	return kind, id, nil
+}
+
+// fullname returns the full ID of the thing of the kind with the ID, which may already be a full ID.
+func fullname(kind, id string) string {
+	return kind + "_" + strings.TrimPrefix(id, kind+"_")
+}
+
+// CommentFullname returns the full ID of the comment with the ID, e.g. t1_abc123 for abc123.
+func CommentFullname(id string) string { return fullname(kindComment, id) }
+
+// UserFullname returns the full ID of the user with the ID, e.g. t2_abc123 for abc123.
+func UserFullname(id string) string { return fullname(kindUser, id) }
+
+// PostFullname returns the full ID of the post with the ID, e.g. t3_abc123 for abc123.
+func PostFullname(id string) string { return fullname(kindPost, id) }
+
+// MessageFullname returns the full ID of the message with the ID, e.g. t4_abc123 for abc123.
+func MessageFullname(id string) string { return fullname(kindMessage, id) }
+
+// SubredditFullname returns the full ID of the subreddit with the ID, e.g. t5_abc123 for abc123.
+func SubredditFullname(id string) string { return fullname(kindSubreddit, id) }
+
+// ParseID returns the number a thing's base 36 ID stands for, e.g. 1000000 for lfls.
+// IDs are assigned in increasing order, so they can be compared this way.
+func ParseID(id string) (int64, error) {
+	n, err := strconv.ParseInt(id, 36, 64)
+	if err != nil || n < 0 || strings.ToLower(id) != id {
+		return 0, fmt.Errorf("invalid ID %q", id)
+	}
+	return n, nil
+}
+
+// FormatID returns the base 36 ID that the number n stands for, e.g. lfls for 1000000.
+func FormatID(n int64) string {
+	return strconv.FormatInt(n, 36)
+}
+
+// ParsePermalink returns the subreddit, post ID and comment ID of a link to a post or comment,
+// e.g. https://www.reddit.com/r/golang/comments/abc123/title/def456/. The link can also be a
+// permalink as returned by the API, e.g. /r/golang/comments/abc123/title/, or a short link,
+// e.g. https://redd.it/abc123. Parts the link doesn't contain are empty.
+func ParsePermalink(link string) (subreddit, postID, commentID string, err error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var parts []string
+	for _, part := range strings.Split(u.Path, "/") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	if strings.TrimPrefix(u.Hostname(), "www.") == "redd.it" {
+		if len(parts) != 1 {
+			return "", "", "", fmt.Errorf("permalink %q: not a link to a post", link)
+		}
+		return "", parts[0], "", nil
+	}
+
+	if len(parts) >= 2 && (parts[0] == "r" || parts[0] == "u" || parts[0] == "user") {
+		if parts[0] == "r" {
+			subreddit = parts[1]
+		} else {
+			subreddit = "u_" + parts[1]
+		}
+		parts = parts[2:]
+	}
+
+	// comments/{post ID}/{title}/{comment ID}, or comments/{post ID}/comment/{comment ID}.
+	if len(parts) < 2 || parts[0] != "comments" {
+		return "", "", "", fmt.Errorf("permalink %q: not a link to a post or comment", link)
+	}
+	postID = parts[1]
+	if len(parts) >= 4 {
+		commentID = parts[3]
+	}
+
+	return subreddit, postID, commentID, nil
+}
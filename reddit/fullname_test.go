@@ -0,0 +1,80 @@
+package reddit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFullname(t *testing.T) {
+	kind, id, err := ParseFullname("t3_abc123")
+	require.NoError(t, err)
+	require.Equal(t, KindPost, kind)
+	require.Equal(t, "abc123", id)
+
+	kind, id, err = ParseFullname("t1_g1xi2m9")
+	require.NoError(t, err)
+	require.Equal(t, KindComment, kind)
+	require.Equal(t, "g1xi2m9", id)
+
+	_, _, err = ParseFullname("abc123")
+	require.EqualError(t, err, `full ID "abc123": missing kind prefix`)
+
+	_, _, err = ParseFullname("t9_abc123")
+	require.EqualError(t, err, `full ID "t9_abc123": unknown kind "t9"`)
+
+	_, _, err = ParseFullname("t3_")
+	require.EqualError(t, err, `full ID "t3_": invalid ID ""`)
+}
+
+func TestFullname(t *testing.T) {
+	require.Equal(t, "t1_abc123", CommentFullname("abc123"))
+	require.Equal(t, "t2_abc123", UserFullname("abc123"))
+	require.Equal(t, "t3_abc123", PostFullname("abc123"))
+	require.Equal(t, "t4_abc123", MessageFullname("abc123"))
+	require.Equal(t, "t5_abc123", SubredditFullname("abc123"))
+	require.Equal(t, "t3_abc123", PostFullname("t3_abc123"))
+}
+
+func TestParseID(t *testing.T) {
+	n, err := ParseID("lfls")
+	require.NoError(t, err)
+	require.Equal(t, int64(1000000), n)
+	require.Equal(t, "lfls", FormatID(n))
+
+	_, err = ParseID("ABC")
+	require.EqualError(t, err, `invalid ID "ABC"`)
+
+	_, err = ParseID("a_b")
+	require.EqualError(t, err, `invalid ID "a_b"`)
+}
+
+func TestParsePermalink(t *testing.T) {
+	tests := []struct {
+		link      string
+		subreddit string
+		postID    string
+		commentID string
+	}{
+		{"https://www.reddit.com/r/golang/comments/abc123/test_post/", "golang", "abc123", ""},
+		{"https://old.reddit.com/r/golang/comments/abc123/test_post/def456/?context=3", "golang", "abc123", "def456"},
+		{"https://www.reddit.com/r/golang/comments/abc123/comment/def456/", "golang", "abc123", "def456"},
+		{"/r/golang/comments/abc123/test_post/", "golang", "abc123", ""},
+		{"/user/test/comments/abc123/test_post/", "u_test", "abc123", ""},
+		{"https://www.reddit.com/comments/abc123", "", "abc123", ""},
+		{"https://redd.it/abc123", "", "abc123", ""},
+	}
+	for _, test := range tests {
+		subreddit, postID, commentID, err := ParsePermalink(test.link)
+		require.NoError(t, err, test.link)
+		require.Equal(t, test.subreddit, subreddit, test.link)
+		require.Equal(t, test.postID, postID, test.link)
+		require.Equal(t, test.commentID, commentID, test.link)
+	}
+
+	_, _, _, err := ParsePermalink("https://www.reddit.com/r/golang/")
+	require.EqualError(t, err, `permalink "https://www.reddit.com/r/golang/": not a link to a post or comment`)
+
+	_, _, _, err = ParsePermalink("https://redd.it/")
+	require.EqualError(t, err, `permalink "https://redd.it/": not a link to a post`)
+}
@@ -17,6 +17,34 @@ type GoldService struct {
 	client *Client
 }
 
+// Award is an award that can be given to posts and comments.
+type Award struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	CoinPrice   int    `json:"coin_price"`
+	IconURL     string `json:"icon_url,omitempty"`
+
+	// One of: global, community.
+	Type string `json:"award_type,omitempty"`
+	// The full ID of the subreddit, if it's a community award.
+	SubredditID string `json:"subreddit_id,omitempty"`
+	IsEnabled   bool   `json:"is_enabled"`
+
+	// How many times the award was given, if it's one of a post or comment's awards.
+	Count int `json:"count,omitempty"`
+}
+
+// AwardRequest represents a request to give an award to a post or comment.
+type AwardRequest struct {
+	// The ID of the award, e.g. award_5f123e3d-4f48-42f4-9c11-e98b566d5897.
+	AwardID string `json:"gild_type"`
+	// If true, the recipient isn't told who gave the award.
+	Anonymous bool `json:"is_anonymous"`
+	// Optional. A private message sent to the recipient with the award.
+	Message string `json:"message,omitempty"`
+}
+
 // Gild the post or comment via its full ID.
 // This requires you to own Reddit coins and will consume them.
 func (s *GoldService) Gild(ctx context.Context, id string) (*Response, error) {
@@ -28,6 +56,28 @@ func (s *GoldService) Gild(ctx context.Context, id string) (*Response, error) {
 	return s.client.Do(ctx, req, nil)
 }
 
+// Award gives an award to the post or comment via its full ID.
+// This requires you to own Reddit coins and will consume them.
+func (s *GoldService) Award(ctx context.Context, id string, request *AwardRequest) (*Response, error) {
+	if request == nil {
+		return nil, errors.New("*AwardRequest: cannot be nil")
+	}
+
+	path := "api/v2/gold/gild"
+
+	body := struct {
+		*AwardRequest
+		ThingID string `json:"thing_id"`
+	}{request, id}
+
+	req, err := s.client.NewJSONRequest(http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // Give the user between 1 and 36 (inclusive) months of gold.
 // This requires you to own Reddit coins and will consume them.
 func (s *GoldService) Give(ctx context.Context, username string, months int) (*Response, error) {
@@ -47,3 +97,49 @@ func (s *GoldService) Give(ctx context.Context, username string, months int) (*R
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// AwardsSeenOnTopPosts returns the community awards that have been given to the subreddit's
+// top 100 posts of all time. It is not the subreddit's award catalog, which Reddit has no
+// public endpoint for: awards no one has given to any of those posts are missing.
+func (s *GoldService) AwardsSeenOnTopPosts(ctx context.Context, subreddit string) ([]*Award, *Response, error) {
+	path := fmt.Sprintf("r/%s/top", subreddit)
+	path, err := addOptions(path, &ListPostOptions{ListOptions: ListOptions{Limit: 100}, Time: "all"})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Data struct {
+			Children []struct {
+				Data struct {
+					Awards []*Award `json:"all_awardings"`
+				} `json:"data"`
+			} `json:"children"`
+		} `json:"data"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var awards []*Award
+	ids := set{}
+	for _, child := range root.Data.Children {
+		for _, award := range child.Data.Awards {
+			if award.Type != "community" || ids.Exists(award.ID) {
+				continue
+			}
+			ids.Add(award.ID)
+			// The count is of the post it was found on, not of the subreddit.
+			award.Count = 0
+			awards = append(awards, award)
+		}
+	}
+
+	return awards, resp, nil
+}
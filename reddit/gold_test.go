@@ -1,6 +1,8 @@
 package reddit
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
@@ -8,6 +10,29 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+var expectedAwardsSeenOnTopPosts = []*Award{
+	{
+		ID:          "award_2385c499-a1fb-44ec-b9b7-d260f3dc55de",
+		Name:        "Golden Gopher",
+		Description: "For the finest Go code.",
+		CoinPrice:   200,
+		IconURL:     "https://i.redd.it/award_images/t5_2rc7j/golden_gopher.png",
+		Type:        "community",
+		SubredditID: "t5_2rc7j",
+		IsEnabled:   true,
+	},
+	{
+		ID:          "award_8d2a1f3e-3f0c-4d4e-bc7f-2d8f6a1e9b11",
+		Name:        "Nil Pointer",
+		Description: "This post made my program panic.",
+		CoinPrice:   50,
+		IconURL:     "https://i.redd.it/award_images/t5_2rc7j/nil_pointer.png",
+		Type:        "community",
+		SubredditID: "t5_2rc7j",
+		IsEnabled:   false,
+	},
+}
+
 func TestGoldService_Gild(t *testing.T) {
 	client, mux := setup(t)
 
@@ -19,6 +44,38 @@ func TestGoldService_Gild(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGoldService_Award(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v2/gold/gild", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body := new(struct {
+			AwardID   string `json:"gild_type"`
+			ThingID   string `json:"thing_id"`
+			Anonymous bool   `json:"is_anonymous"`
+			Message   string `json:"message"`
+		})
+
+		err := json.NewDecoder(r.Body).Decode(body)
+		require.NoError(t, err)
+		require.Equal(t, "gid_2", body.AwardID)
+		require.Equal(t, "t1_test", body.ThingID)
+		require.True(t, body.Anonymous)
+		require.Equal(t, "nice one", body.Message)
+	})
+
+	_, err := client.Gold.Award(ctx, "t1_test", nil)
+	require.EqualError(t, err, "*AwardRequest: cannot be nil")
+
+	_, err = client.Gold.Award(ctx, "t1_test", &AwardRequest{
+		AwardID:   "gid_2",
+		Anonymous: true,
+		Message:   "nice one",
+	})
+	require.NoError(t, err)
+}
+
 func TestGoldService_Give(t *testing.T) {
 	client, mux := setup(t)
 
@@ -42,3 +99,28 @@ func TestGoldService_Give(t *testing.T) {
 	_, err = client.Gold.Give(ctx, "testuser", 1)
 	require.NoError(t, err)
 }
+
+func TestGoldService_AwardsSeenOnTopPosts(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/gold/community-awards.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/golang/top", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "100")
+		form.Set("t", "all")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	awards, _, err := client.Gold.AwardsSeenOnTopPosts(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, expectedAwardsSeenOnTopPosts, awards)
+}
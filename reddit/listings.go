@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -29,6 +30,57 @@ func (s *ListingsService) Get(ctx context.Context, ids ...string) ([]*Post, []*C
 	return l.Posts(), l.Comments(), l.Subreddits(), resp, nil
 }
 
+// GetBatch gets any number of posts, comments, and subreddits from their full IDs, sending them
+// to Reddit 100 at a time. The things are returned in the order Reddit returned them. The
+// results are in the same order as ids, with each full ID as the result's Input; the ones
+// Reddit returned nothing for get ErrNotFound. If a chunk of ids fails as a whole, each of
+// its items gets that error.
+func (s *ListingsService) GetBatch(ctx context.Context, ids []string) ([]Thing, []ItemResult, *Response, error) {
+	if len(ids) == 0 {
+		return nil, nil, nil, errors.New("ids: must provide at least 1")
+	}
+
+	results := make([]ItemResult, len(ids))
+	for i, id := range ids {
+		results[i].Input = id
+	}
+
+	var things []Thing
+	var resp *Response
+	for start := 0; start < len(ids); start += 100 {
+		end := start + 100
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		params := struct {
+			IDs []string `url:"id,omitempty,comma"`
+		}{ids[start:end]}
+
+		chunk, chunkResp, err := s.client.getThings(ctx, "api/info", params)
+		if chunkResp != nil {
+			resp = chunkResp
+		}
+
+		found := set{}
+		for _, thing := range chunk {
+			found.Add(thing.Fullname())
+		}
+		things = append(things, chunk...)
+
+		for i := start; i < end; i++ {
+			switch {
+			case err != nil:
+				results[i].Err = err
+			case !found.Exists(ids[i]):
+				results[i].Err = ErrNotFound
+			}
+		}
+	}
+
+	return things, results, resp, nil
+}
+
 // GetPosts returns posts from their full IDs.
 func (s *ListingsService) GetPosts(ctx context.Context, ids ...string) ([]*Post, *Response, error) {
 	path := fmt.Sprintf("by_id/%s", strings.Join(ids, ","))
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,7 +16,6 @@ var expectedListingPosts = []*Post{
 		ID:      "i2gvg4",
 		FullID:  "t3_i2gvg4",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 8, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/",
 		URL:       "https://www.reddit.com/r/test/comments/i2gvg4/this_is_a_title/",
@@ -45,14 +45,14 @@ var expectedListingComments = []*Comment{
 		ID:      "g05v931",
 		FullID:  "t1_g05v931",
 		Created: &Timestamp{time.Date(2020, 8, 3, 1, 15, 40, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		ParentID:  "t3_i2gvg4",
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/g05v931/",
 
-		Body:     "Test comment",
-		Author:   "v_95",
-		AuthorID: "t2_164ab8",
+		Body:            "Test comment",
+		Author:          "v_95",
+		AuthorID:        "t2_164ab8",
+		AuthorFlairType: "text",
 
 		SubredditName:         "test",
 		SubredditNamePrefixed: "r/test",
@@ -83,6 +83,12 @@ var expectedListingSubreddits = []*Subreddit{
 
 		Subscribers: 8202,
 		Subscribed:  true,
+
+		SubmissionType: "any",
+		AllowImages:    true,
+		AllowVideos:    true,
+		AllowGalleries: true,
+		AllowPolls:     true,
 	},
 }
 
@@ -91,7 +97,6 @@ var expectedListingPosts2 = []*Post{
 		ID:      "i2gvg4",
 		FullID:  "t3_i2gvg4",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 8, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/",
 		URL:       "https://www.reddit.com/r/test/comments/i2gvg4/this_is_a_title/",
@@ -118,7 +123,6 @@ var expectedListingPosts2 = []*Post{
 		ID:      "i2gvs1",
 		FullID:  "t3_i2gvs1",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 37, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/i2gvs1/this_is_a_title/",
 		URL:       "http://example.com",
@@ -166,6 +170,56 @@ func TestListingsService_Get(t *testing.T) {
 	require.Equal(t, expectedListingSubreddits, subreddits)
 }
 
+func TestListingsService_GetBatch(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/listings/posts-comments-subreddits.json")
+	require.NoError(t, err)
+
+	var requests []string
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		requests = append(requests, r.Form.Get("id"))
+
+		if len(requests) == 1 {
+			fmt.Fprint(w, blob)
+			return
+		}
+		http.Error(w, "", http.StatusInternalServerError)
+	})
+
+	ids := []string{"t5_2qh23", "t3_i2gvg4", "t3_missing", "t1_g05v931"}
+	for i := 0; i < 100; i++ {
+		ids = append(ids, fmt.Sprintf("t3_%d", i))
+	}
+
+	_, _, _, err = client.Listings.GetBatch(ctx, nil)
+	require.EqualError(t, err, "ids: must provide at least 1")
+
+	things, results, _, err := client.Listings.GetBatch(ctx, ids)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	require.Equal(t, strings.Join(ids[:100], ","), requests[0])
+	require.Equal(t, strings.Join(ids[100:], ","), requests[1])
+
+	require.Len(t, things, 3)
+	require.Equal(t, expectedListingSubreddits[0], things[0])
+	require.Equal(t, expectedListingPosts[0], things[1])
+	require.Equal(t, expectedListingComments[0], things[2])
+
+	require.Len(t, results, len(ids))
+	require.Equal(t, ItemResult{Input: "t5_2qh23"}, results[0])
+	require.Equal(t, ItemResult{Input: "t3_i2gvg4"}, results[1])
+	require.Equal(t, ItemResult{Input: "t3_missing", Err: ErrNotFound}, results[2])
+	require.Equal(t, ItemResult{Input: "t1_g05v931"}, results[3])
+	require.Equal(t, "t3_96", results[100].Input)
+	require.Error(t, results[100].Err)
+	require.Equal(t, results[100].Err, results[103].Err)
+}
+
 func TestListingsService_GetPosts(t *testing.T) {
 	client, mux := setup(t)
 
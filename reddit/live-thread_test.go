@@ -108,7 +108,6 @@ var expectedLiveThreadDiscussions = []*Post{
 		ID:      "test1",
 		FullID:  "t3_test1",
 		Created: &Timestamp{time.Date(2020, 9, 16, 12, 37, 31, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/live/comments/test1/test_title/",
 		URL:       "https://www.reddit.com/live/15nfp4mtfbo14/",
@@ -126,12 +125,16 @@ var expectedLiveThreadDiscussions = []*Post{
 
 		Author:   "TestUser",
 		AuthorID: "t2_test1",
+
+		Media: &PostMedia{
+			Type:         "liveupdate",
+			LiveThreadID: "15nfp4mtfbo14",
+		},
 	},
 	{
 		ID:      "test2",
 		FullID:  "t3_test2",
 		Created: &Timestamp{time.Date(2020, 9, 16, 12, 37, 1, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/live/comments/test2/test_title/",
 		URL:       "https://www.reddit.com/live/15nfp4mtfbo14/",
@@ -149,6 +152,11 @@ var expectedLiveThreadDiscussions = []*Post{
 
 		Author:   "TestUser",
 		AuthorID: "t2_test1",
+
+		Media: &PostMedia{
+			Type:         "liveupdate",
+			LiveThreadID: "15nfp4mtfbo14",
+		},
 	},
 }
 
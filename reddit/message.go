@@ -33,8 +33,39 @@ type Message struct {
 	To     string `json:"dest"`
 
 	IsComment bool `json:"was_comment"`
+	// What the message is, e.g. a private message or a reply to one of your comments.
+	Type MessageType `json:"type,omitempty"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *Message) UnmarshalJSON(b []byte) error {
+	type message Message
+	err := json.Unmarshal(b, (*message)(m))
+	if err != nil {
+		return err
+	}
+
+	// Reddit sets the type of private messages to "unknown".
+	if !m.IsComment {
+		m.Type = MessageTypePrivate
+	}
+	return nil
+}
+
+// MessageType is the type of a message in your inbox.
+type MessageType string
+
+const (
+	// MessageTypePrivate is a private message.
+	MessageTypePrivate MessageType = "private_message"
+	// MessageTypeCommentReply is a reply to one of your comments.
+	MessageTypeCommentReply MessageType = "comment_reply"
+	// MessageTypePostReply is a comment on one of your posts.
+	MessageTypePostReply MessageType = "post_reply"
+	// MessageTypeUsernameMention is a comment that mentions your username.
+	MessageTypeUsernameMention MessageType = "username_mention"
+)
+
 type inboxThing struct {
 	Kind string   `json:"kind"`
 	Data *Message `json:"data"`
@@ -104,6 +135,11 @@ type SendMessageRequest struct {
 	Text    string `url:"text"`
 	// Optional. If specified, the message will look like it came from the subreddit.
 	FromSubreddit string `url:"from_sr,omitempty"`
+
+	// Only needed if AccountService.NeedsCaptcha returns true: the iden from
+	// AccountService.NewCaptcha, and the text in the captcha's image.
+	Iden    string `url:"iden,omitempty"`
+	Captcha string `url:"captcha,omitempty"`
 }
 
 // ReadAll marks all messages/comments as read. It queues up the task on Reddit's end.
@@ -237,6 +273,7 @@ func (s *MessageService) Send(ctx context.Context, sendRequest *SendMessageReque
 		return nil, err
 	}
 	form.Set("api_type", "json")
+	form.Set("text", s.client.withFooter(ctx, sendRequest.Text))
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -246,6 +283,39 @@ func (s *MessageService) Send(ctx context.Context, sendRequest *SendMessageReque
 	return s.client.Do(ctx, req, nil)
 }
 
+// Reply to a private message via its full ID, e.g. t4_abc123.
+// The reply is sent to the other participant of the conversation.
+func (s *MessageService) Reply(ctx context.Context, id string, text string) (*Message, *Response, error) {
+	path := "api/comment"
+
+	form := url.Values{}
+	form.Set("api_type", "json")
+	form.Set("parent", id)
+	form.Set("text", s.client.withFooter(ctx, text))
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		JSON struct {
+			Data struct {
+				Things []inboxThing `json:"things"`
+			} `json:"data"`
+		} `json:"json"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(root.JSON.Data.Things) == 0 {
+		return nil, resp, errors.New("response contains no message")
+	}
+	return root.JSON.Data.Things[0].Data, resp, nil
+}
+
 // Inbox returns comments and messages that appear in your inbox, respectively.
 func (s *MessageService) Inbox(ctx context.Context, opts *ListOptions) ([]*Message, []*Message, *Response, error) {
 	root, resp, err := s.inbox(ctx, "message/inbox", opts)
@@ -273,6 +343,24 @@ func (s *MessageService) Sent(ctx context.Context, opts *ListOptions) ([]*Messag
 	return root.Messages, resp, nil
 }
 
+// Mentions returns comments in which your username was mentioned.
+func (s *MessageService) Mentions(ctx context.Context, opts *ListOptions) ([]*Message, *Response, error) {
+	root, resp, err := s.inbox(ctx, "message/mentions", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.Comments, resp, nil
+}
+
+// CommentReplies returns replies to your comments and comments on your posts.
+func (s *MessageService) CommentReplies(ctx context.Context, opts *ListOptions) ([]*Message, *Response, error) {
+	root, resp, err := s.inbox(ctx, "message/comments", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return root.Comments, resp, nil
+}
+
 func (s *MessageService) inbox(ctx context.Context, path string, opts *ListOptions) (*inboxListing, *Response, error) {
 	path, err := addOptions(path, opts)
 	if err != nil {
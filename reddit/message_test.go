@@ -24,6 +24,7 @@ var expectedCommentMessages = []*Message{
 		To:     "testuser2",
 
 		IsComment: true,
+		Type:      MessageTypePostReply,
 	},
 }
 
@@ -41,6 +42,7 @@ var expectedMessages = []*Message{
 		To:     "testuser2",
 
 		IsComment: false,
+		Type:      MessageTypePrivate,
 	},
 }
 
@@ -207,6 +209,97 @@ func TestMessageService_Send(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMessageService_Send_Captcha(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/compose", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("to", "test")
+		form.Set("subject", "test subject")
+		form.Set("text", "test text")
+		form.Set("iden", "Xc2GJxmjUS7OBzGqAKZrxFTy6cCSv3Qh")
+		form.Set("captcha", "abcdef")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Message.Send(ctx, &SendMessageRequest{
+		To:      "test",
+		Subject: "test subject",
+		Text:    "test text",
+		Iden:    "Xc2GJxmjUS7OBzGqAKZrxFTy6cCSv3Qh",
+		Captcha: "abcdef",
+	})
+	require.NoError(t, err)
+}
+
+func TestMessageService_Reply(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/message/reply.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("parent", "t4_qwki97")
+		form.Set("text", "test reply")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	message, _, err := client.Message.Reply(ctx, "t4_qwki97", "test reply")
+	require.NoError(t, err)
+	require.Equal(t, &Message{
+		ID:      "qwkj2x",
+		FullID:  "t4_qwkj2x",
+		Created: &Timestamp{time.Date(2020, 8, 18, 1, 16, 53, 0, time.UTC)},
+
+		Subject:  "re: test",
+		Text:     "test reply",
+		ParentID: "t4_qwki97",
+
+		Author: "testuser2",
+		To:     "testuser1",
+
+		Type: MessageTypePrivate,
+	}, message)
+}
+
+func TestMessageService_Send_DisclosureFooter(t *testing.T) {
+	client, mux := setup(t)
+	err := WithDisclosureFooter("^(I am a bot)")(client)
+	require.NoError(t, err)
+
+	var texts []string
+	mux.HandleFunc("/api/compose", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		texts = append(texts, r.PostForm.Get("text"))
+	})
+
+	_, err = client.Message.Send(ctx, &SendMessageRequest{To: "test", Subject: "test subject", Text: "test text"})
+	require.NoError(t, err)
+
+	_, err = client.Message.Send(WithoutDisclosureFooter(ctx), &SendMessageRequest{To: "test", Subject: "test subject", Text: "test text"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"test text\n\n^(I am a bot)", "test text"}, texts)
+}
+
 func TestMessageService_Inbox(t *testing.T) {
 	client, mux := setup(t)
 
@@ -256,3 +349,35 @@ func TestMessageService_Sent(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedMessages, messages)
 }
+
+func TestMessageService_Mentions(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/message/inbox.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/mentions", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	comments, _, err := client.Message.Mentions(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedCommentMessages, comments)
+}
+
+func TestMessageService_CommentReplies(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/message/inbox.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/comments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	comments, _, err := client.Message.CommentReplies(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedCommentMessages, comments)
+}
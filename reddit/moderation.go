@@ -2,10 +2,12 @@ package reddit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
@@ -88,6 +90,27 @@ func (p *ModPermissions) String() (s string) {
 	return
 }
 
+func newModPermissions(permissions []string) *ModPermissions {
+	granted := make(map[string]bool, len(permissions))
+	for _, permission := range permissions {
+		granted[permission] = true
+	}
+
+	p := new(ModPermissions)
+	t := reflect.TypeOf(*p)
+	v := reflect.ValueOf(p).Elem()
+
+	for i := 0; i < t.NumField(); i++ {
+		if v.Field(i).Kind() != reflect.Bool {
+			continue
+		}
+		permission := t.Field(i).Tag.Get("permission")
+		v.Field(i).SetBool(granted["all"] || granted[permission])
+	}
+
+	return p
+}
+
 // BanConfig configures the ban of the user being banned.
 type BanConfig struct {
 	Reason string `url:"reason,omitempty"`
@@ -99,6 +122,60 @@ type BanConfig struct {
 	Message string `url:"ban_message,omitempty"`
 }
 
+// RemovalReason is a reason moderators can give for removing a post or comment.
+type RemovalReason struct {
+	ID      string `json:"id,omitempty"`
+	Title   string `json:"title,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// RemovalReasonRequest represents a request to create or update a removal reason.
+type RemovalReasonRequest struct {
+	Title string `url:"title"`
+	// Raw markdown text of the message sent to the author of the removed post or comment.
+	Message string `url:"message"`
+}
+
+func (r *RemovalReasonRequest) validate() error {
+	if r == nil {
+		return errors.New("*RemovalReasonRequest: cannot be nil")
+	}
+	if r.Title == "" {
+		return errors.New("(*RemovalReasonRequest).Title: cannot be empty")
+	}
+	return nil
+}
+
+// RemovalMessageRequest represents a request to tell the author of a removed post or
+// comment why it was removed.
+type RemovalMessageRequest struct {
+	// Full ID of the removed post or comment.
+	ItemID string `json:"-"`
+	// Subject of the message. Only used for private messages.
+	Title string `json:"title"`
+	// Raw markdown text.
+	Message string `json:"message"`
+	// One of: public (reply to the post or comment), private (modmail),
+	// private_exposed (modmail that shows which moderator sent it).
+	Type string `json:"type"`
+}
+
+func (r *RemovalMessageRequest) validate() error {
+	if r == nil {
+		return errors.New("*RemovalMessageRequest: cannot be nil")
+	}
+	if r.ItemID == "" {
+		return errors.New("(*RemovalMessageRequest).ItemID: cannot be empty")
+	}
+	switch r.Type {
+	case "public", "private", "private_exposed":
+		// intentionally left blank
+	default:
+		return errors.New("(*RemovalMessageRequest).Type: must be one of: public, private, private_exposed")
+	}
+	return nil
+}
+
 // Actions gets a list of moderator actions on a subreddit.
 func (s *ModerationService) Actions(ctx context.Context, subreddit string, opts *ListModActionOptions) ([]*ModAction, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/log", subreddit)
@@ -232,6 +309,13 @@ func (s *ModerationService) Queue(ctx context.Context, subreddit string, opts *L
 	return l.Posts(), l.Comments(), resp, nil
 }
 
+// QueueThings returns the posts and comments requiring moderator reviews, in the order
+// they are in the queue.
+func (s *ModerationService) QueueThings(ctx context.Context, subreddit string, opts *ListModQueueOptions) ([]Thing, *Response, error) {
+	path := fmt.Sprintf("r/%s/about/modqueue", subreddit)
+	return s.client.getThings(ctx, path, opts)
+}
+
 // Unmoderated returns posts that have yet to be approved/removed by a mod.
 func (s *ModerationService) Unmoderated(ctx context.Context, subreddit string, opts *ListOptions) ([]*Post, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/unmoderated", subreddit)
@@ -252,6 +336,24 @@ func (s *ModerationService) Edited(ctx context.Context, subreddit string, opts *
 	return l.Posts(), l.Comments(), resp, nil
 }
 
+// ListQueue returns the posts and comments in one of the subreddit's moderation queues, and
+// can be limited to either with opts.Only.
+// queue is one of: reports, spam, modqueue, unmoderated, edited.
+func (s *ModerationService) ListQueue(ctx context.Context, subreddit, queue string, opts *ListModQueueOptions) ([]*Post, []*Comment, *Response, error) {
+	switch queue {
+	case "reports", "spam", "modqueue", "unmoderated", "edited":
+	default:
+		return nil, nil, nil, errors.New("queue: must be one of reports, spam, modqueue, unmoderated, edited")
+	}
+
+	path := fmt.Sprintf("r/%s/about/%s", subreddit, queue)
+	l, resp, err := s.client.getListing(ctx, path, opts)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	return l.Posts(), l.Comments(), resp, nil
+}
+
 // IgnoreReports prevents reports on a post or comment from causing notifications.
 func (s *ModerationService) IgnoreReports(ctx context.Context, id string) (*Response, error) {
 	path := "api/ignore_reports"
@@ -492,3 +594,177 @@ func (s *ModerationService) Undistinguish(ctx context.Context, id string) (*Resp
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// Undo reverses a mod action from the moderation log, e.g. approving what the action removed,
+// or unbanning who it banned. It supports approvals, removals, bans, mutes, stickies, locks,
+// distinguishing, and ignoring reports. Other actions return an error wrapping ErrUndoUnsupported.
+func (s *ModerationService) Undo(ctx context.Context, action *ModAction) (*Response, error) {
+	if action == nil {
+		return nil, errors.New("*ModAction: cannot be nil")
+	}
+
+	switch action.Action {
+	case "approvelink", "approvecomment":
+		return s.Remove(ctx, action.TargetID)
+	case "removelink", "removecomment", "spamlink", "spamcomment":
+		return s.Approve(ctx, action.TargetID)
+	case "banuser":
+		return s.Unban(ctx, action.Subreddit, action.TargetAuthor)
+	case "muteuser":
+		return s.Unmute(ctx, action.Subreddit, action.TargetAuthor)
+	case "sticky":
+		return s.client.Post.Unsticky(ctx, action.TargetID)
+	case "lock":
+		return s.client.Post.Unlock(ctx, action.TargetID)
+	case "unlock":
+		return s.client.Post.Lock(ctx, action.TargetID)
+	case "distinguish":
+		return s.Undistinguish(ctx, action.TargetID)
+	case "ignorereports":
+		return s.UnignoreReports(ctx, action.TargetID)
+	case "unignorereports":
+		return s.IgnoreReports(ctx, action.TargetID)
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUndoUnsupported, action.Action)
+}
+
+// RemovalReasons gets the subreddit's removal reasons, in the order set by its moderators.
+func (s *ModerationService) RemovalReasons(ctx context.Context, subreddit string) ([]*RemovalReason, *Response, error) {
+	path := fmt.Sprintf("api/v1/%s/removal_reasons", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Data  map[string]*RemovalReason `json:"data"`
+		Order []string                  `json:"order"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	reasons := make([]*RemovalReason, 0, len(root.Order))
+	for _, id := range root.Order {
+		if reason, ok := root.Data[id]; ok {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return reasons, resp, nil
+}
+
+// CreateRemovalReason adds a removal reason to the subreddit and returns its id.
+func (s *ModerationService) CreateRemovalReason(ctx context.Context, subreddit string, request *RemovalReasonRequest) (string, *Response, error) {
+	err := request.validate()
+	if err != nil {
+		return "", nil, err
+	}
+
+	path := fmt.Sprintf("api/v1/%s/removal_reasons", subreddit)
+
+	form, err := query.Values(request)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return "", nil, err
+	}
+
+	root := new(struct {
+		ID string `json:"id"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return root.ID, resp, nil
+}
+
+// UpdateRemovalReason updates the title and message of the subreddit's removal reason.
+func (s *ModerationService) UpdateRemovalReason(ctx context.Context, subreddit string, id string, request *RemovalReasonRequest) (*Response, error) {
+	err := request.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("api/v1/%s/removal_reasons/%s", subreddit, id)
+
+	form, err := query.Values(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodPut, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteRemovalReason deletes the subreddit's removal reason.
+func (s *ModerationService) DeleteRemovalReason(ctx context.Context, subreddit string, id string) (*Response, error) {
+	path := fmt.Sprintf("api/v1/%s/removal_reasons/%s", subreddit, id)
+
+	req, err := s.client.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ApplyRemovalReason attaches a removal reason to removed posts and/or comments via their full IDs.
+// The note is only visible to moderators, and reasonID may be empty to only attach a note.
+func (s *ModerationService) ApplyRemovalReason(ctx context.Context, reasonID string, note string, ids ...string) (*Response, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("must provide at least 1 id")
+	}
+
+	path := "api/v1/modactions/removal_reasons"
+
+	body := struct {
+		ItemIDs  []string `json:"item_ids"`
+		ModNote  string   `json:"mod_note"`
+		ReasonID string   `json:"reason_id"`
+	}{ids, note, reasonID}
+
+	req, err := s.client.NewJSONRequest(http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// SendRemovalMessage tells the author of a removed post or comment why it was removed.
+func (s *ModerationService) SendRemovalMessage(ctx context.Context, request *RemovalMessageRequest) (*Response, error) {
+	err := request.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	path := "api/v1/modactions/removal_comment_message"
+	if strings.HasPrefix(request.ItemID, kindPost+"_") {
+		path = "api/v1/modactions/removal_link_message"
+	}
+
+	body := struct {
+		ItemID []string `json:"item_id"`
+		*RemovalMessageRequest
+	}{[]string{request.ItemID}, request}
+
+	req, err := s.client.NewJSONRequest(http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
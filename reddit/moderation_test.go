@@ -1,6 +1,8 @@
 package reddit
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -258,6 +260,62 @@ func TestModerationService_Queue(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestModerationService_QueueThings(t *testing.T) {
+	client, mux := setup(t)
+
+	// contains posts and comments
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/modqueue", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	things, resp, err := client.Moderation.QueueThings(ctx, "testsubreddit", nil)
+	require.NoError(t, err)
+	require.Len(t, things, 2)
+	require.Equal(t, kindPost, things[0].Kind())
+	require.Equal(t, expectedPost.FullID, things[0].Fullname())
+	require.Equal(t, kindComment, things[1].Kind())
+	require.Equal(t, expectedComment.FullID, things[1].Fullname())
+	require.Equal(t, "t1_f0zsa37", resp.After)
+}
+
+func TestModerationService_ListQueue(t *testing.T) {
+	client, mux := setup(t)
+
+	// contains posts and comments
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/spam", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("only", "comments")
+		form.Set("limit", "10")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, _, err = client.Moderation.ListQueue(ctx, "testsubreddit", "queue", nil)
+	require.EqualError(t, err, "queue: must be one of reports, spam, modqueue, unmoderated, edited")
+
+	posts, comments, resp, err := client.Moderation.ListQueue(ctx, "testsubreddit", "spam", &ListModQueueOptions{
+		ListOptions: ListOptions{Limit: 10},
+		Only:        "comments",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*Post{expectedPost}, posts)
+	require.Equal(t, []*Comment{expectedComment}, comments)
+	require.Equal(t, "t1_f0zsa37", resp.After)
+}
+
 func TestModerationService_Unmoderated(t *testing.T) {
 	client, mux := setup(t)
 
@@ -699,3 +757,200 @@ func TestModerationService_Undistinguish(t *testing.T) {
 	_, err := client.Moderation.Undistinguish(ctx, "t1_123")
 	require.NoError(t, err)
 }
+
+func TestModerationService_Undo(t *testing.T) {
+	client, mux := setup(t)
+
+	var paths []string
+	var forms []url.Values
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		paths = append(paths, r.URL.Path)
+		forms = append(forms, r.PostForm)
+	}
+	mux.HandleFunc("/api/approve", handler)
+	mux.HandleFunc("/api/remove", handler)
+	mux.HandleFunc("/r/testsubreddit/api/unfriend", handler)
+	mux.HandleFunc("/api/set_subreddit_sticky", handler)
+
+	_, err := client.Moderation.Undo(ctx, nil)
+	require.EqualError(t, err, "*ModAction: cannot be nil")
+
+	_, err = client.Moderation.Undo(ctx, &ModAction{Action: "removelink", TargetID: "t3_123"})
+	require.NoError(t, err)
+	_, err = client.Moderation.Undo(ctx, &ModAction{Action: "approvecomment", TargetID: "t1_123"})
+	require.NoError(t, err)
+	_, err = client.Moderation.Undo(ctx, &ModAction{Action: "banuser", Subreddit: "testsubreddit", TargetAuthor: "user1"})
+	require.NoError(t, err)
+	_, err = client.Moderation.Undo(ctx, &ModAction{Action: "sticky", TargetID: "t3_123"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/api/approve", "/api/remove", "/r/testsubreddit/api/unfriend", "/api/set_subreddit_sticky"}, paths)
+	require.Equal(t, "t3_123", forms[0].Get("id"))
+	require.Equal(t, "t1_123", forms[1].Get("id"))
+	require.Equal(t, "false", forms[1].Get("spam"))
+	require.Equal(t, "user1", forms[2].Get("name"))
+	require.Equal(t, "banned", forms[2].Get("type"))
+	require.Equal(t, "t3_123", forms[3].Get("id"))
+	require.Equal(t, "false", forms[3].Get("state"))
+
+	_, err = client.Moderation.Undo(ctx, &ModAction{Action: "unbanuser", Subreddit: "testsubreddit", TargetAuthor: "user1"})
+	require.True(t, errors.Is(err, ErrUndoUnsupported))
+	require.EqualError(t, err, `reddit: mod action cannot be undone: "unbanuser"`)
+}
+
+func TestModerationService_RemovalReasons(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/moderation/removal-reasons.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/testsubreddit/removal_reasons", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	reasons, _, err := client.Moderation.RemovalReasons(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, []*RemovalReason{
+		{ID: "16ibz2ml7v9k8", Title: "Spam", Message: "Your post was removed because it is spam."},
+		{ID: "16ibyjgsjqfmr", Title: "Rule 1", Message: "Your post was removed because it breaks rule 1."},
+	}, reasons)
+}
+
+func TestModerationService_CreateRemovalReason(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/testsubreddit/removal_reasons", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("title", "Spam")
+		form.Set("message", "Your post was removed because it is spam.")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, `{"id": "16ibz2ml7v9k8"}`)
+	})
+
+	_, _, err := client.Moderation.CreateRemovalReason(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "*RemovalReasonRequest: cannot be nil")
+
+	_, _, err = client.Moderation.CreateRemovalReason(ctx, "testsubreddit", &RemovalReasonRequest{})
+	require.EqualError(t, err, "(*RemovalReasonRequest).Title: cannot be empty")
+
+	id, _, err := client.Moderation.CreateRemovalReason(ctx, "testsubreddit", &RemovalReasonRequest{
+		Title:   "Spam",
+		Message: "Your post was removed because it is spam.",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "16ibz2ml7v9k8", id)
+}
+
+func TestModerationService_UpdateRemovalReason(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/testsubreddit/removal_reasons/16ibz2ml7v9k8", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+
+		form := url.Values{}
+		form.Set("title", "Spam")
+		form.Set("message", "Removed for spam.")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.UpdateRemovalReason(ctx, "testsubreddit", "16ibz2ml7v9k8", &RemovalReasonRequest{
+		Title:   "Spam",
+		Message: "Removed for spam.",
+	})
+	require.NoError(t, err)
+}
+
+func TestModerationService_DeleteRemovalReason(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/testsubreddit/removal_reasons/16ibz2ml7v9k8", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+	})
+
+	_, err := client.Moderation.DeleteRemovalReason(ctx, "testsubreddit", "16ibz2ml7v9k8")
+	require.NoError(t, err)
+}
+
+func TestModerationService_ApplyRemovalReason(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/modactions/removal_reasons", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body := new(struct {
+			ItemIDs  []string `json:"item_ids"`
+			ModNote  string   `json:"mod_note"`
+			ReasonID string   `json:"reason_id"`
+		})
+		err := json.NewDecoder(r.Body).Decode(body)
+		require.NoError(t, err)
+		require.Equal(t, []string{"t3_test1", "t1_test2"}, body.ItemIDs)
+		require.Equal(t, "test note", body.ModNote)
+		require.Equal(t, "16ibz2ml7v9k8", body.ReasonID)
+	})
+
+	_, err := client.Moderation.ApplyRemovalReason(ctx, "16ibz2ml7v9k8", "test note")
+	require.EqualError(t, err, "must provide at least 1 id")
+
+	_, err = client.Moderation.ApplyRemovalReason(ctx, "16ibz2ml7v9k8", "test note", "t3_test1", "t1_test2")
+	require.NoError(t, err)
+}
+
+func TestModerationService_SendRemovalMessage(t *testing.T) {
+	client, mux := setup(t)
+
+	expectBody := func(t *testing.T, r *http.Request, itemID string) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body := make(map[string]interface{})
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"item_id": []interface{}{itemID},
+			"title":   "Removed",
+			"message": "test message",
+			"type":    "public",
+		}, body)
+	}
+
+	mux.HandleFunc("/api/v1/modactions/removal_link_message", func(w http.ResponseWriter, r *http.Request) {
+		expectBody(t, r, "t3_test1")
+	})
+	mux.HandleFunc("/api/v1/modactions/removal_comment_message", func(w http.ResponseWriter, r *http.Request) {
+		expectBody(t, r, "t1_test2")
+	})
+
+	_, err := client.Moderation.SendRemovalMessage(ctx, nil)
+	require.EqualError(t, err, "*RemovalMessageRequest: cannot be nil")
+
+	_, err = client.Moderation.SendRemovalMessage(ctx, &RemovalMessageRequest{ItemID: "t3_test1", Type: "test"})
+	require.EqualError(t, err, "(*RemovalMessageRequest).Type: must be one of: public, private, private_exposed")
+
+	_, err = client.Moderation.SendRemovalMessage(ctx, &RemovalMessageRequest{
+		ItemID:  "t3_test1",
+		Title:   "Removed",
+		Message: "test message",
+		Type:    "public",
+	})
+	require.NoError(t, err)
+
+	_, err = client.Moderation.SendRemovalMessage(ctx, &RemovalMessageRequest{
+		ItemID:  "t1_test2",
+		Title:   "Removed",
+		Message: "test message",
+		Type:    "public",
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,463 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ModmailService handles communication with the new modmail
+// related methods of the Reddit API.
+//
+// Reddit API docs: https://www.reddit.com/dev/api/#section_modmail
+type ModmailService struct {
+	client *Client
+}
+
+// ModmailState is the state of a modmail conversation.
+type ModmailState int
+
+const (
+	// ModmailStateNew is a conversation no moderator has replied to yet.
+	ModmailStateNew ModmailState = iota
+	// ModmailStateInProgress is a conversation a moderator has replied to.
+	ModmailStateInProgress
+	// ModmailStateArchived is an archived conversation.
+	ModmailStateArchived
+	// ModmailStateAppeal is a ban appeal.
+	ModmailStateAppeal
+	// ModmailStateJoinRequest is a request to join a private subreddit.
+	ModmailStateJoinRequest
+	// ModmailStateFiltered is a conversation that was filtered, e.g. for being spam.
+	ModmailStateFiltered
+)
+
+// ModmailConversation is a conversation in a subreddit's modmail.
+type ModmailConversation struct {
+	ID      string       `json:"id,omitempty"`
+	Subject string       `json:"subject,omitempty"`
+	State   ModmailState `json:"state"`
+
+	// The subreddit the conversation belongs to.
+	Subreddit   string `json:"-"`
+	SubredditID string `json:"-"`
+
+	// The user the moderators are talking with, if there is one.
+	Participant *ModmailAuthor   `json:"participant,omitempty"`
+	Authors     []*ModmailAuthor `json:"authors,omitempty"`
+
+	NumberOfMessages int `json:"numMessages"`
+
+	// Sent by AutoModerator or Reddit, rather than by a user.
+	IsAuto bool `json:"isAuto"`
+	// Between moderators only.
+	IsInternal    bool `json:"isInternal"`
+	IsHighlighted bool `json:"isHighlighted"`
+	IsRepliable   bool `json:"isRepliable"`
+
+	LastUpdated    *Timestamp `json:"lastUpdated,omitempty"`
+	LastUserUpdate *Timestamp `json:"lastUserUpdate,omitempty"`
+	LastModUpdate  *Timestamp `json:"lastModUpdate,omitempty"`
+	LastUnread     *Timestamp `json:"lastUnread,omitempty"`
+
+	// The messages and mod actions of the conversation, oldest first.
+	// When listing conversations, only the most recent message is included.
+	Messages   []*ModmailMessage `json:"-"`
+	ModActions []*ModmailAction  `json:"-"`
+
+	// The ids of the conversation's messages and mod actions, in order.
+	objects []modmailObject
+}
+
+type modmailObject struct {
+	ID string `json:"id"`
+	// Either messages or modActions.
+	Key string `json:"key"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *ModmailConversation) UnmarshalJSON(b []byte) error {
+	type conversation ModmailConversation
+	root := struct {
+		*conversation
+		Owner struct {
+			Name string `json:"displayName"`
+			ID   string `json:"id"`
+		} `json:"owner"`
+		Objects []modmailObject `json:"objIds"`
+	}{conversation: (*conversation)(c)}
+
+	err := json.Unmarshal(b, &root)
+	if err != nil {
+		return err
+	}
+
+	c.Subreddit = root.Owner.Name
+	c.SubredditID = root.Owner.ID
+	c.objects = root.Objects
+	modmailTimesToUTC(c.LastUpdated, c.LastUserUpdate, c.LastModUpdate, c.LastUnread)
+	return nil
+}
+
+// modmailTimesToUTC converts the timestamps to UTC. Unlike the rest of the API, modmail sends
+// RFC3339 times with an offset, e.g. 2020-09-01T18:03:27.012345+00:00, which would otherwise
+// decode into a fixed zone rather than UTC like every other Timestamp.
+func modmailTimesToUTC(timestamps ...*Timestamp) {
+	for _, t := range timestamps {
+		if t != nil {
+			t.Time = t.Time.UTC()
+		}
+	}
+}
+
+// addObjects fills in the conversation's messages and mod actions from the ones in the response.
+func (c *ModmailConversation) addObjects(messages map[string]*ModmailMessage, modActions map[string]*ModmailAction) {
+	for _, obj := range c.objects {
+		switch obj.Key {
+		case "messages":
+			if message, ok := messages[obj.ID]; ok {
+				c.Messages = append(c.Messages, message)
+			}
+		case "modActions":
+			if action, ok := modActions[obj.ID]; ok {
+				c.ModActions = append(c.ModActions, action)
+			}
+		}
+	}
+}
+
+// ModmailAuthor is a user taking part in a modmail conversation.
+type ModmailAuthor struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+
+	IsMod   bool `json:"isMod"`
+	IsAdmin bool `json:"isAdmin"`
+	// The user who started the conversation.
+	IsOP          bool `json:"isOp"`
+	IsParticipant bool `json:"isParticipant"`
+	// A moderator who replied as the subreddit.
+	IsHidden  bool `json:"isHidden"`
+	IsDeleted bool `json:"isDeleted"`
+}
+
+// ModmailMessage is a message in a modmail conversation.
+type ModmailMessage struct {
+	ID      string         `json:"id"`
+	Author  *ModmailAuthor `json:"author"`
+	Created *Timestamp     `json:"date,omitempty"`
+
+	Body     string `json:"bodyMarkdown"`
+	BodyHTML string `json:"body"`
+
+	// Only visible to moderators.
+	IsInternal bool `json:"isInternal"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *ModmailMessage) UnmarshalJSON(b []byte) error {
+	type message ModmailMessage
+	err := json.Unmarshal(b, (*message)(m))
+	if err != nil {
+		return err
+	}
+
+	modmailTimesToUTC(m.Created)
+	return nil
+}
+
+// ModmailAction is an action a moderator took on a modmail conversation.
+type ModmailAction struct {
+	ID      string         `json:"id"`
+	Author  *ModmailAuthor `json:"author"`
+	Created *Timestamp     `json:"date,omitempty"`
+
+	// One of: 0 (highlight), 1 (unhighlight), 2 (archive), 3 (unarchive), 4 (report to admins),
+	// 5 (mute), 6 (unmute), 7 (ban), 8 (unban), 9 (approve), 10 (disapprove).
+	Type int `json:"actionTypeId"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *ModmailAction) UnmarshalJSON(b []byte) error {
+	type action ModmailAction
+	err := json.Unmarshal(b, (*action)(a))
+	if err != nil {
+		return err
+	}
+
+	modmailTimesToUTC(a.Created)
+	return nil
+}
+
+// ModmailCreateRequest represents a request to start a modmail conversation.
+type ModmailCreateRequest struct {
+	// The subreddit whose moderators the conversation is with.
+	Subreddit string `url:"srName"`
+	// The user the conversation is with. If empty, the conversation is between moderators only.
+	To      string `url:"to,omitempty"`
+	Subject string `url:"subject"`
+	Body    string `url:"body"`
+	// If true, the message will look like it came from the subreddit rather than the client's user.
+	AuthorHidden bool `url:"isAuthorHidden,omitempty"`
+}
+
+// ModmailReplyRequest represents a request to reply to a modmail conversation.
+type ModmailReplyRequest struct {
+	Body string `url:"body"`
+	// If true, the message will look like it came from the subreddit rather than the client's user.
+	AuthorHidden bool `url:"isAuthorHidden,omitempty"`
+	// If true, the message is a private moderator note, not visible to the participant.
+	Internal bool `url:"isInternal,omitempty"`
+}
+
+// ModmailUnreadCount is the number of unread modmail conversations in each state.
+type ModmailUnreadCount struct {
+	New           int `json:"new"`
+	InProgress    int `json:"inprogress"`
+	Archived      int `json:"archived"`
+	Highlighted   int `json:"highlighted"`
+	Mod           int `json:"mod"`
+	Notifications int `json:"notifications"`
+	Appeals       int `json:"appeals"`
+	JoinRequests  int `json:"join_requests"`
+	Filtered      int `json:"filtered"`
+}
+
+type modmailConversationRoot struct {
+	Conversation *ModmailConversation       `json:"conversation"`
+	Messages     map[string]*ModmailMessage `json:"messages"`
+	ModActions   map[string]*ModmailAction  `json:"modActions"`
+}
+
+func (r *modmailConversationRoot) conversation() *ModmailConversation {
+	if r.Conversation == nil {
+		return nil
+	}
+	r.Conversation.addObjects(r.Messages, r.ModActions)
+	return r.Conversation
+}
+
+// Conversations gets modmail conversations, most recently updated first.
+// The returned response's After is the ID of the last conversation, to be used to get the next
+// page. It is empty once there are no more conversations.
+func (s *ModmailService) Conversations(ctx context.Context, opts *ListModmailOptions) ([]*ModmailConversation, *Response, error) {
+	path, err := addOptions("api/mod/conversations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Conversations map[string]*ModmailConversation `json:"conversations"`
+		IDs           []string                        `json:"conversationIds"`
+		Messages      map[string]*ModmailMessage      `json:"messages"`
+		ModActions    map[string]*ModmailAction       `json:"modActions"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	conversations := make([]*ModmailConversation, 0, len(root.IDs))
+	for _, id := range root.IDs {
+		conversation, ok := root.Conversations[id]
+		if !ok {
+			continue
+		}
+		conversation.addObjects(root.Messages, root.ModActions)
+		conversations = append(conversations, conversation)
+	}
+
+	if len(root.IDs) > 0 {
+		resp.After = root.IDs[len(root.IDs)-1]
+	}
+
+	return conversations, resp, nil
+}
+
+// Conversation gets a modmail conversation with all of its messages and mod actions.
+// If markRead is true, the conversation is marked as read.
+func (s *ModmailService) Conversation(ctx context.Context, id string, markRead bool) (*ModmailConversation, *Response, error) {
+	params := struct {
+		MarkRead bool `url:"markRead,omitempty"`
+	}{markRead}
+
+	path, err := addOptions(fmt.Sprintf("api/mod/conversations/%s", id), params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(modmailConversationRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.conversation(), resp, nil
+}
+
+// CreateConversation starts a modmail conversation.
+func (s *ModmailService) CreateConversation(ctx context.Context, createRequest *ModmailCreateRequest) (*ModmailConversation, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, errors.New("*ModmailCreateRequest: cannot be nil")
+	}
+
+	form, err := query.Values(createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	form.Set("body", s.client.withFooter(ctx, createRequest.Body))
+
+	return s.post(ctx, "api/mod/conversations", form)
+}
+
+// Reply to a modmail conversation via its ID.
+func (s *ModmailService) Reply(ctx context.Context, id string, replyRequest *ModmailReplyRequest) (*ModmailConversation, *Response, error) {
+	if replyRequest == nil {
+		return nil, nil, errors.New("*ModmailReplyRequest: cannot be nil")
+	}
+
+	form, err := query.Values(replyRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Internal notes are only seen by moderators, so they don't need the footer.
+	if !replyRequest.Internal {
+		form.Set("body", s.client.withFooter(ctx, replyRequest.Body))
+	}
+
+	return s.post(ctx, fmt.Sprintf("api/mod/conversations/%s", id), form)
+}
+
+func (s *ModmailService) post(ctx context.Context, path string, form url.Values) (*ModmailConversation, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(modmailConversationRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.conversation(), resp, nil
+}
+
+// Archive a modmail conversation via its ID.
+func (s *ModmailService) Archive(ctx context.Context, id string) (*Response, error) {
+	return s.action(ctx, http.MethodPost, id, "archive")
+}
+
+// Unarchive a modmail conversation via its ID.
+func (s *ModmailService) Unarchive(ctx context.Context, id string) (*Response, error) {
+	return s.action(ctx, http.MethodPost, id, "unarchive")
+}
+
+// Highlight a modmail conversation via its ID.
+func (s *ModmailService) Highlight(ctx context.Context, id string) (*Response, error) {
+	return s.action(ctx, http.MethodPost, id, "highlight")
+}
+
+// Unhighlight a modmail conversation via its ID.
+func (s *ModmailService) Unhighlight(ctx context.Context, id string) (*Response, error) {
+	return s.action(ctx, http.MethodDelete, id, "highlight")
+}
+
+// Mute the participant of a modmail conversation via its ID, so they can't send modmail to the
+// subreddit for the given number of hours, which must be 72, 168 or 672 (3, 7 or 28 days).
+func (s *ModmailService) Mute(ctx context.Context, id string, hours int) (*Response, error) {
+	switch hours {
+	case 72, 168, 672:
+	default:
+		return nil, errors.New("hours: must be one of 72, 168, 672")
+	}
+
+	path := fmt.Sprintf("api/mod/conversations/%s/mute", id)
+
+	form := url.Values{}
+	form.Set("num_hours", strconv.Itoa(hours))
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Unmute the participant of a modmail conversation via its ID.
+func (s *ModmailService) Unmute(ctx context.Context, id string) (*Response, error) {
+	return s.action(ctx, http.MethodPost, id, "unmute")
+}
+
+func (s *ModmailService) action(ctx context.Context, method, id, action string) (*Response, error) {
+	path := fmt.Sprintf("api/mod/conversations/%s/%s", id, action)
+	req, err := s.client.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// BulkRead marks all the modmail conversations in the given state as read, and returns their IDs.
+// The state is one of: all, new, inprogress, archived, mod, notifications, appeals, join_requests,
+// highlighted. If no subreddits are provided, conversations from all the subreddits the client
+// moderates are marked as read.
+func (s *ModmailService) BulkRead(ctx context.Context, state string, subreddits ...string) ([]string, *Response, error) {
+	path := "api/mod/conversations/bulk/read"
+
+	form := url.Values{}
+	form.Set("state", state)
+	if len(subreddits) > 0 {
+		form.Set("entity", strings.Join(subreddits, ","))
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		IDs []string `json:"conversation_ids"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.IDs, resp, nil
+}
+
+// UnreadCount gets the number of unread modmail conversations in each state.
+func (s *ModmailService) UnreadCount(ctx context.Context) (*ModmailUnreadCount, *Response, error) {
+	path := "api/mod/conversations/unread/count"
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ModmailUnreadCount)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
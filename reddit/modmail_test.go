@@ -0,0 +1,405 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	modmailUser = &ModmailAuthor{
+		ID:            6711246,
+		Name:          "testuser2",
+		IsOP:          true,
+		IsParticipant: true,
+	}
+	modmailMod = &ModmailAuthor{
+		ID:    5421879,
+		Name:  "testuser1",
+		IsMod: true,
+		IsOP:  true,
+	}
+	modmailHiddenMod = &ModmailAuthor{
+		ID:       5421879,
+		Name:     "testuser1",
+		IsMod:    true,
+		IsHidden: true,
+	}
+)
+
+var expectedModmailConversations = []*ModmailConversation{
+	{
+		ID:      "fz6ja",
+		Subject: "question",
+		State:   ModmailStateNew,
+
+		Subreddit:   "testsubreddit",
+		SubredditID: "t5_2uquw1",
+
+		Participant: modmailUser,
+		Authors:     []*ModmailAuthor{modmailUser},
+
+		NumberOfMessages: 1,
+		IsRepliable:      true,
+
+		LastUpdated:    &Timestamp{time.Date(2020, 9, 1, 18, 3, 27, 12345000, time.UTC)},
+		LastUserUpdate: &Timestamp{time.Date(2020, 9, 1, 18, 3, 27, 12345000, time.UTC)},
+		LastUnread:     &Timestamp{time.Date(2020, 9, 1, 18, 3, 27, 12345000, time.UTC)},
+
+		Messages: []*ModmailMessage{
+			{
+				ID:       "mnk1x",
+				Author:   modmailUser,
+				Created:  &Timestamp{time.Date(2020, 9, 1, 18, 3, 27, 12345000, time.UTC)},
+				Body:     "hello",
+				BodyHTML: `<!-- SC_OFF --><div class="md"><p>hello</p></div><!-- SC_ON -->`,
+			},
+		},
+
+		objects: []modmailObject{{ID: "mnk1x", Key: "messages"}},
+	},
+	{
+		ID:      "fz6j9",
+		Subject: "mod discussion",
+		State:   ModmailStateInProgress,
+
+		Subreddit:   "testsubreddit",
+		SubredditID: "t5_2uquw1",
+
+		Authors: []*ModmailAuthor{modmailMod},
+
+		NumberOfMessages: 1,
+		IsInternal:       true,
+		IsHighlighted:    true,
+		IsRepliable:      true,
+
+		LastUpdated:   &Timestamp{time.Date(2020, 8, 31, 12, 0, 0, 0, time.UTC)},
+		LastModUpdate: &Timestamp{time.Date(2020, 8, 31, 12, 0, 0, 0, time.UTC)},
+
+		Messages: []*ModmailMessage{
+			{
+				ID:         "mnk1w",
+				Author:     modmailMod,
+				Created:    &Timestamp{time.Date(2020, 8, 31, 12, 0, 0, 0, time.UTC)},
+				Body:       "just us",
+				BodyHTML:   `<!-- SC_OFF --><div class="md"><p>just us</p></div><!-- SC_ON -->`,
+				IsInternal: true,
+			},
+		},
+
+		objects: []modmailObject{{ID: "mnk1w", Key: "messages"}},
+	},
+}
+
+var expectedModmailConversation = &ModmailConversation{
+	ID:      "fz6ja",
+	Subject: "question",
+	State:   ModmailStateInProgress,
+
+	Subreddit:   "testsubreddit",
+	SubredditID: "t5_2uquw1",
+
+	Participant: modmailUser,
+	Authors:     []*ModmailAuthor{modmailUser, modmailHiddenMod},
+
+	NumberOfMessages: 2,
+	IsHighlighted:    true,
+	IsRepliable:      true,
+
+	LastUpdated:    &Timestamp{time.Date(2020, 9, 1, 18, 10, 0, 0, time.UTC)},
+	LastUserUpdate: &Timestamp{time.Date(2020, 9, 1, 18, 3, 27, 12345000, time.UTC)},
+	LastModUpdate:  &Timestamp{time.Date(2020, 9, 1, 18, 10, 0, 0, time.UTC)},
+
+	Messages: []*ModmailMessage{
+		{
+			ID:       "mnk1x",
+			Author:   modmailUser,
+			Created:  &Timestamp{time.Date(2020, 9, 1, 18, 3, 27, 12345000, time.UTC)},
+			Body:     "hello",
+			BodyHTML: `<!-- SC_OFF --><div class="md"><p>hello</p></div><!-- SC_ON -->`,
+		},
+		{
+			ID:       "mnk1y",
+			Author:   modmailHiddenMod,
+			Created:  &Timestamp{time.Date(2020, 9, 1, 18, 10, 0, 0, time.UTC)},
+			Body:     "hi there",
+			BodyHTML: `<!-- SC_OFF --><div class="md"><p>hi there</p></div><!-- SC_ON -->`,
+		},
+	},
+	ModActions: []*ModmailAction{
+		{
+			ID:      "d8wq2",
+			Author:  &ModmailAuthor{ID: 5421879, Name: "testuser1", IsMod: true},
+			Created: &Timestamp{time.Date(2020, 9, 1, 18, 5, 0, 0, time.UTC)},
+			Type:    0,
+		},
+	},
+
+	objects: []modmailObject{
+		{ID: "mnk1x", Key: "messages"},
+		{ID: "d8wq2", Key: "modActions"},
+		{ID: "mnk1y", Key: "messages"},
+	},
+}
+
+func TestModmailService_Conversations(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/modmail/conversations.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("entity", "testsubreddit,testsubreddit2")
+		form.Set("sort", "recent")
+		form.Set("state", "inprogress")
+		form.Set("limit", "2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	conversations, resp, err := client.Modmail.Conversations(ctx, &ListModmailOptions{
+		ListOptions: ListOptions{Limit: 2},
+		Subreddits:  []string{"testsubreddit", "testsubreddit2"},
+		Sort:        "recent",
+		State:       "inprogress",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedModmailConversations, conversations)
+	require.Equal(t, "fz6j9", resp.After)
+}
+
+func TestModmailService_Conversation(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/modmail/conversation.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations/fz6ja", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("markRead", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	conversation, _, err := client.Modmail.Conversation(ctx, "fz6ja", true)
+	require.NoError(t, err)
+	require.Equal(t, expectedModmailConversation, conversation)
+}
+
+func TestModmailService_CreateConversation(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/modmail/conversation.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("srName", "testsubreddit")
+		form.Set("to", "testuser2")
+		form.Set("subject", "question")
+		form.Set("body", "hello")
+		form.Set("isAuthorHidden", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Modmail.CreateConversation(ctx, nil)
+	require.EqualError(t, err, "*ModmailCreateRequest: cannot be nil")
+
+	conversation, _, err := client.Modmail.CreateConversation(ctx, &ModmailCreateRequest{
+		Subreddit:    "testsubreddit",
+		To:           "testuser2",
+		Subject:      "question",
+		Body:         "hello",
+		AuthorHidden: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedModmailConversation, conversation)
+}
+
+func TestModmailService_Reply(t *testing.T) {
+	client, mux := setup(t)
+	err := WithDisclosureFooter("^(I am a bot)")(client)
+	require.NoError(t, err)
+
+	blob, err := readFileContents("../testdata/modmail/conversation.json")
+	require.NoError(t, err)
+
+	var expectedForm url.Values
+	mux.HandleFunc("/api/mod/conversations/fz6ja", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, expectedForm, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Modmail.Reply(ctx, "fz6ja", nil)
+	require.EqualError(t, err, "*ModmailReplyRequest: cannot be nil")
+
+	expectedForm = url.Values{}
+	expectedForm.Set("body", "hi there\n\n^(I am a bot)")
+	expectedForm.Set("isAuthorHidden", "true")
+
+	conversation, _, err := client.Modmail.Reply(ctx, "fz6ja", &ModmailReplyRequest{
+		Body:         "hi there",
+		AuthorHidden: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedModmailConversation, conversation)
+
+	expectedForm = url.Values{}
+	expectedForm.Set("body", "note to mods")
+	expectedForm.Set("isInternal", "true")
+
+	_, _, err = client.Modmail.Reply(ctx, "fz6ja", &ModmailReplyRequest{
+		Body:     "note to mods",
+		Internal: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestModmailService_Archive(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/mod/conversations/fz6ja/archive", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.Archive(ctx, "fz6ja")
+	require.NoError(t, err)
+}
+
+func TestModmailService_Unarchive(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/mod/conversations/fz6ja/unarchive", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.Unarchive(ctx, "fz6ja")
+	require.NoError(t, err)
+}
+
+func TestModmailService_Highlight(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/mod/conversations/fz6ja/highlight", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.Highlight(ctx, "fz6ja")
+	require.NoError(t, err)
+}
+
+func TestModmailService_Unhighlight(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/mod/conversations/fz6ja/highlight", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+	})
+
+	_, err := client.Modmail.Unhighlight(ctx, "fz6ja")
+	require.NoError(t, err)
+}
+
+func TestModmailService_Mute(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/mod/conversations/fz6ja/mute", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("num_hours", "168")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Modmail.Mute(ctx, "fz6ja", 24)
+	require.EqualError(t, err, "hours: must be one of 72, 168, 672")
+
+	_, err = client.Modmail.Mute(ctx, "fz6ja", 168)
+	require.NoError(t, err)
+}
+
+func TestModmailService_Unmute(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/mod/conversations/fz6ja/unmute", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Modmail.Unmute(ctx, "fz6ja")
+	require.NoError(t, err)
+}
+
+func TestModmailService_BulkRead(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/mod/conversations/bulk/read", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("state", "new")
+		form.Set("entity", "testsubreddit,testsubreddit2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, `{"conversation_ids": ["fz6ja", "fz6j9"]}`)
+	})
+
+	ids, _, err := client.Modmail.BulkRead(ctx, "new", "testsubreddit", "testsubreddit2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"fz6ja", "fz6j9"}, ids)
+}
+
+func TestModmailService_UnreadCount(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/modmail/unread-count.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations/unread/count", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	count, _, err := client.Modmail.UnreadCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &ModmailUnreadCount{
+		New:           3,
+		InProgress:    1,
+		Highlighted:   2,
+		Notifications: 5,
+		Appeals:       1,
+	}, count)
+}
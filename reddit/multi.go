@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
@@ -90,6 +91,14 @@ type MultiCopyRequest struct {
 	DisplayName string `url:"display_name,omitempty"`
 }
 
+// MultiRenameRequest represents a request to rename a multireddit.
+type MultiRenameRequest struct {
+	FromPath string `url:"from"`
+	ToPath   string `url:"to"`
+	// No longer than 50 characters.
+	DisplayName string `url:"display_name,omitempty"`
+}
+
 // MultiCreateOrUpdateRequest represents a request to create/update a multireddit.
 type MultiCreateOrUpdateRequest struct {
 	// For updates, this is the display name, i.e. the header of the multi.
@@ -178,6 +187,33 @@ func (s *MultiService) Copy(ctx context.Context, copyRequest *MultiCopyRequest)
 	return multi, resp, nil
 }
 
+// Rename a multireddit, i.e. move it to a new url path.
+func (s *MultiService) Rename(ctx context.Context, renameRequest *MultiRenameRequest) (*Multi, *Response, error) {
+	if renameRequest == nil {
+		return nil, nil, errors.New("*MultiRenameRequest: cannot be nil")
+	}
+
+	path := "api/multi/rename"
+	form, err := query.Values(renameRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(thing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	multi, _ := root.Multi()
+	return multi, resp, nil
+}
+
 // Create a multireddit.
 func (s *MultiService) Create(ctx context.Context, createRequest *MultiCreateOrUpdateRequest) (*Multi, *Response, error) {
 	if createRequest == nil {
@@ -305,3 +341,42 @@ func (s *MultiService) DeleteSubreddit(ctx context.Context, multiPath string, su
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+func (s *MultiService) getPosts(ctx context.Context, sort string, multiPath string, opts interface{}) ([]*Post, *Response, error) {
+	path := fmt.Sprintf("%s/%s", strings.Trim(multiPath, "/"), sort)
+	l, resp, err := s.client.getListing(ctx, path, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Posts(), resp, nil
+}
+
+// HotPosts returns the hottest posts from the subreddits in the multireddit.
+// The multireddit's path is in the format user/{username}/m/{multiname}, as in Multi.Path.
+func (s *MultiService) HotPosts(ctx context.Context, multiPath string, opts *ListOptions) ([]*Post, *Response, error) {
+	return s.getPosts(ctx, "hot", multiPath, opts)
+}
+
+// NewPosts returns the newest posts from the subreddits in the multireddit.
+// The multireddit's path is in the format user/{username}/m/{multiname}, as in Multi.Path.
+func (s *MultiService) NewPosts(ctx context.Context, multiPath string, opts *ListOptions) ([]*Post, *Response, error) {
+	return s.getPosts(ctx, "new", multiPath, opts)
+}
+
+// RisingPosts returns the rising posts from the subreddits in the multireddit.
+// The multireddit's path is in the format user/{username}/m/{multiname}, as in Multi.Path.
+func (s *MultiService) RisingPosts(ctx context.Context, multiPath string, opts *ListOptions) ([]*Post, *Response, error) {
+	return s.getPosts(ctx, "rising", multiPath, opts)
+}
+
+// ControversialPosts returns the most controversial posts from the subreddits in the multireddit.
+// The multireddit's path is in the format user/{username}/m/{multiname}, as in Multi.Path.
+func (s *MultiService) ControversialPosts(ctx context.Context, multiPath string, opts *ListPostOptions) ([]*Post, *Response, error) {
+	return s.getPosts(ctx, "controversial", multiPath, opts)
+}
+
+// TopPosts returns the top posts from the subreddits in the multireddit.
+// The multireddit's path is in the format user/{username}/m/{multiname}, as in Multi.Path.
+func (s *MultiService) TopPosts(ctx context.Context, multiPath string, opts *ListPostOptions) ([]*Post, *Response, error) {
+	return s.getPosts(ctx, "top", multiPath, opts)
+}
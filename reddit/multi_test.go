@@ -126,6 +126,39 @@ func TestMultiService_Copy(t *testing.T) {
 	require.Equal(t, expectedMulti, multi)
 }
 
+func TestMultiService_Rename(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/multi/multi.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/multi/rename", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("from", "user/testuser/m/testmulti")
+		form.Set("to", "user/testuser/m/testmulti2")
+		form.Set("display_name", "hello")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Multi.Rename(ctx, nil)
+	require.EqualError(t, err, "*MultiRenameRequest: cannot be nil")
+
+	multi, _, err := client.Multi.Rename(ctx, &MultiRenameRequest{
+		FromPath:    "user/testuser/m/testmulti",
+		ToPath:      "user/testuser/m/testmulti2",
+		DisplayName: "hello",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedMulti, multi)
+}
+
 func TestMultiService_Create(t *testing.T) {
 	client, mux := setup(t)
 
@@ -278,3 +311,49 @@ func TestMultiService_DeleteSubreddit(t *testing.T) {
 	_, err := client.Multi.DeleteSubreddit(ctx, "user/testuser/m/testmulti", "golang")
 	require.NoError(t, err)
 }
+
+func TestMultiService_HotPosts(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/user/testuser/m/testmulti/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Multi.HotPosts(ctx, "/user/testuser/m/testmulti/", nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
+func TestMultiService_TopPosts(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/user/testuser/m/testmulti/top", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "10")
+		form.Set("t", "week")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Multi.TopPosts(ctx, "user/testuser/m/testmulti", &ListPostOptions{
+		ListOptions: ListOptions{Limit: 10},
+		Time:        "week",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/google/go-querystring/query"
@@ -33,6 +34,59 @@ type Submitted struct {
 	URL    string `json:"url,omitempty"`
 }
 
+// CrosspostGraph describes how a post spread across Reddit, via crossposts and
+// duplicate submissions of the same URL.
+type CrosspostGraph struct {
+	// The post the graph was built from.
+	Root *Post
+	// The posts discovered while building the graph, keyed by their full IDs.
+	Posts map[string]*Post
+	// Edges between the posts in the graph.
+	// An edge might point to a post that isn't in Posts if it was beyond the exploration limits.
+	Edges []*CrosspostGraphEdge
+}
+
+// CrosspostGraphEdgeKind is the kind of relation between two posts in a CrosspostGraph.
+type CrosspostGraphEdgeKind string
+
+const (
+	// CrosspostGraphEdgeCrosspost means that From is a crosspost of To.
+	CrosspostGraphEdgeCrosspost CrosspostGraphEdgeKind = "crosspost"
+	// CrosspostGraphEdgeDuplicate means that From and To are separate submissions of the same URL.
+	CrosspostGraphEdgeDuplicate CrosspostGraphEdgeKind = "duplicate"
+)
+
+// CrosspostGraphEdge is a relation between two posts in a CrosspostGraph, via their full IDs.
+type CrosspostGraphEdge struct {
+	From string
+	To   string
+	Kind CrosspostGraphEdgeKind
+}
+
+// Crossposts returns the posts in the graph that are crossposts of the post with the full ID.
+func (g *CrosspostGraph) Crossposts(id string) []*Post {
+	var posts []*Post
+	for _, edge := range g.Edges {
+		if edge.Kind != CrosspostGraphEdgeCrosspost || edge.To != id {
+			continue
+		}
+		if post, ok := g.Posts[edge.From]; ok {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+// CrosspostGraphOptions limits how far CrosspostGraph explores.
+type CrosspostGraphOptions struct {
+	// Maximum number of hops away from the starting post to explore.
+	// If 0 or less, it defaults to 3.
+	MaxDepth int
+	// Maximum number of requests made to build the graph. Each explored post takes one request.
+	// If 0 or less, it defaults to 25.
+	MaxRequests int
+}
+
 // SubmitTextRequest are options used for text posts.
 type SubmitTextRequest struct {
 	Subreddit string `url:"sr,omitempty"`
@@ -45,6 +99,11 @@ type SubmitTextRequest struct {
 	SendReplies *bool `url:"sendreplies,omitempty"`
 	NSFW        bool  `url:"nsfw,omitempty"`
 	Spoiler     bool  `url:"spoiler,omitempty"`
+
+	// The iden of a captcha from AccountService.NewCaptcha, and the answer to it,
+	// if AccountService.NeedsCaptcha says one is needed.
+	Iden    string `url:"iden,omitempty"`
+	Captcha string `url:"captcha,omitempty"`
 }
 
 // SubmitLinkRequest are options used for link posts.
@@ -60,6 +119,10 @@ type SubmitLinkRequest struct {
 	Resubmit    bool  `url:"resubmit,omitempty"`
 	NSFW        bool  `url:"nsfw,omitempty"`
 	Spoiler     bool  `url:"spoiler,omitempty"`
+
+	// A captcha and the answer to it, like in SubmitTextRequest.
+	Iden    string `url:"iden,omitempty"`
+	Captcha string `url:"captcha,omitempty"`
 }
 
 // Get a post with its comments.
@@ -105,13 +168,109 @@ func (s *PostService) Duplicates(ctx context.Context, id string, opts *ListDupli
 	listing1, _ := root[0].Listing()
 	listing2, _ := root[1].Listing()
 
-	post := listing1.Posts()[0]
+	posts := listing1.Posts()
+	if len(posts) == 0 {
+		return nil, nil, resp, errNoPost
+	}
+
+	post := posts[0]
 	duplicates := listing2.Posts()
 
 	resp.After = listing2.After()
 	return post, duplicates, resp, nil
 }
 
+// CrosspostGraph builds a graph of where the post with the id went, by recursively following
+// its crosspost parents and its duplicates (which include its crossposts).
+// Posts that were already explored are not explored again, so cycles are not an issue.
+// id is the ID36 of the post, not its full id.
+// The returned response is the one of the last request made.
+func (s *PostService) CrosspostGraph(ctx context.Context, id string, opts *CrosspostGraphOptions) (*CrosspostGraph, *Response, error) {
+	maxDepth, maxRequests := 3, 25
+	if opts != nil && opts.MaxDepth > 0 {
+		maxDepth = opts.MaxDepth
+	}
+	if opts != nil && opts.MaxRequests > 0 {
+		maxRequests = opts.MaxRequests
+	}
+
+	type node struct {
+		id    string
+		depth int
+	}
+
+	graph := &CrosspostGraph{Posts: make(map[string]*Post)}
+	queued := set{}
+	edges := set{}
+
+	addEdge := func(from, to string, kind CrosspostGraphEdgeKind) {
+		key := fmt.Sprintf("%s>%s", from, to)
+		if kind == CrosspostGraphEdgeDuplicate {
+			// duplicates go both ways, so store the pair in a consistent order
+			if from > to {
+				from, to = to, from
+			}
+			key = fmt.Sprintf("%s=%s", from, to)
+		}
+		if edges.Exists(key) {
+			return
+		}
+		edges.Add(key)
+		graph.Edges = append(graph.Edges, &CrosspostGraphEdge{From: from, To: to, Kind: kind})
+	}
+
+	queue := []node{{kindPost + "_" + strings.TrimPrefix(id, kindPost+"_"), 0}}
+	queued.Add(queue[0].id)
+
+	var resp *Response
+	for requests := 0; len(queue) > 0 && requests < maxRequests; requests++ {
+		current := queue[0]
+		queue = queue[1:]
+
+		post, duplicates, r, err := s.Duplicates(ctx, strings.TrimPrefix(current.id, kindPost+"_"), &ListDuplicatePostOptions{
+			ListOptions: ListOptions{Limit: 100},
+		})
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+
+		graph.Posts[post.FullID] = post
+		if graph.Root == nil {
+			graph.Root = post
+		}
+
+		explore := func(id string) {
+			if current.depth < maxDepth && !queued.Exists(id) {
+				queued.Add(id)
+				queue = append(queue, node{id, current.depth + 1})
+			}
+		}
+
+		if post.CrosspostParentID != "" {
+			addEdge(post.FullID, post.CrosspostParentID, CrosspostGraphEdgeCrosspost)
+			explore(post.CrosspostParentID)
+		}
+
+		for _, duplicate := range duplicates {
+			if _, ok := graph.Posts[duplicate.FullID]; !ok {
+				graph.Posts[duplicate.FullID] = duplicate
+			}
+
+			if duplicate.CrosspostParentID != "" {
+				addEdge(duplicate.FullID, duplicate.CrosspostParentID, CrosspostGraphEdgeCrosspost)
+			}
+			if duplicate.CrosspostParentID != post.FullID && post.CrosspostParentID != duplicate.FullID {
+				addEdge(post.FullID, duplicate.FullID, CrosspostGraphEdgeDuplicate)
+			}
+
+			explore(duplicate.FullID)
+		}
+	}
+
+	return graph, resp, nil
+}
+
 func (s *PostService) submit(ctx context.Context, v interface{}) (*Submitted, *Response, error) {
 	path := "api/submit"
 
@@ -135,8 +294,40 @@ func (s *PostService) submit(ctx context.Context, v interface{}) (*Submitted, *R
 	return root.JSON.Data, resp, nil
 }
 
+// checkFlairRequirement fills in the flair id of a post about to be submitted, if the client
+// checks post flair requirements and the subreddit requires one.
+func (s *PostService) checkFlairRequirement(ctx context.Context, subreddit string, flairID *string) (*Response, error) {
+	if s.client.defaultPostFlairs == nil || *flairID != "" {
+		return nil, nil
+	}
+
+	requirements, resp, err := s.client.Subreddit.PostRequirements(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+	if !requirements.FlairRequired {
+		return nil, nil
+	}
+
+	if id, ok := s.client.defaultPostFlairs[strings.ToLower(subreddit)]; ok {
+		*flairID = id
+		return nil, nil
+	}
+
+	flairs, resp, err := s.client.Flair.GetPostFlairs(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, &FlairRequiredError{Subreddit: subreddit, Choices: flairs}
+}
+
 // SubmitText submits a text post.
 func (s *PostService) SubmitText(ctx context.Context, opts SubmitTextRequest) (*Submitted, *Response, error) {
+	if resp, err := s.checkFlairRequirement(ctx, opts.Subreddit, &opts.FlairID); err != nil {
+		return nil, resp, err
+	}
+
 	form := struct {
 		SubmitTextRequest
 		Kind string `url:"kind,omitempty"`
@@ -146,6 +337,10 @@ func (s *PostService) SubmitText(ctx context.Context, opts SubmitTextRequest) (*
 
 // SubmitLink submits a link post.
 func (s *PostService) SubmitLink(ctx context.Context, opts SubmitLinkRequest) (*Submitted, *Response, error) {
+	if resp, err := s.checkFlairRequirement(ctx, opts.Subreddit, &opts.FlairID); err != nil {
+		return nil, resp, err
+	}
+
 	form := struct {
 		SubmitLinkRequest
 		Kind string `url:"kind,omitempty"`
@@ -153,6 +348,56 @@ func (s *PostService) SubmitLink(ctx context.Context, opts SubmitLinkRequest) (*
 	return s.submit(ctx, form)
 }
 
+// profileSubreddit returns the name of your profile's subreddit, i.e. u_{username}.
+// If the client wasn't given a username, your account is looked up to get it.
+func (s *PostService) profileSubreddit(ctx context.Context) (string, *Response, error) {
+	username := s.client.Username
+	if username == "" {
+		user, resp, err := s.client.Account.Info(ctx)
+		if err != nil {
+			return "", resp, err
+		}
+		username = user.Name
+	}
+	return "u_" + username, nil, nil
+}
+
+// SubmitTextToProfile submits a text post to your profile instead of a subreddit.
+// The Subreddit field of opts is ignored. Posts on your profile can be read with
+// SubredditService.NewPosts, using u_{username} as the subreddit.
+func (s *PostService) SubmitTextToProfile(ctx context.Context, opts SubmitTextRequest) (*Submitted, *Response, error) {
+	subreddit, resp, err := s.profileSubreddit(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	opts.Subreddit = subreddit
+
+	form := struct {
+		SubmitTextRequest
+		Kind       string `url:"kind,omitempty"`
+		SubmitType string `url:"submit_type,omitempty"`
+	}{opts, "self", "profile"}
+	return s.submit(ctx, form)
+}
+
+// SubmitLinkToProfile submits a link post to your profile instead of a subreddit.
+// The Subreddit field of opts is ignored. Posts on your profile can be read with
+// SubredditService.NewPosts, using u_{username} as the subreddit.
+func (s *PostService) SubmitLinkToProfile(ctx context.Context, opts SubmitLinkRequest) (*Submitted, *Response, error) {
+	subreddit, resp, err := s.profileSubreddit(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	opts.Subreddit = subreddit
+
+	form := struct {
+		SubmitLinkRequest
+		Kind       string `url:"kind,omitempty"`
+		SubmitType string `url:"submit_type,omitempty"`
+	}{opts, "link", "profile"}
+	return s.submit(ctx, form)
+}
+
 // Edit a post.
 func (s *PostService) Edit(ctx context.Context, id string, text string) (*Post, *Response, error) {
 	path := "api/editusertext"
@@ -275,6 +520,13 @@ func (s *PostService) Unspoiler(ctx context.Context, id string) (*Response, erro
 	return s.client.Do(ctx, req, nil)
 }
 
+// SetFlair assigns the flair template, via its id, to the post. If text isn't empty and the
+// template is editable, it replaces the flair's text. It is the same as (*FlairService).SelectForPost.
+// Flair can also be set when submitting a post, via the request's FlairID and FlairText.
+func (s *PostService) SetFlair(ctx context.Context, id, templateID, text string) (*Response, error) {
+	return s.client.Flair.SelectForPost(ctx, id, &FlairSelectRequest{ID: templateID, Text: text})
+}
+
 // Sticky a post in its subreddit.
 // When bottom is true, the post will be set as the bottom sticky (the 2nd one).
 // If no top sticky exists, the post will become the top sticky regardless.
@@ -464,6 +716,29 @@ func (s *PostService) DisableContestMode(ctx context.Context, id string) (*Respo
 	return s.client.Do(ctx, req, nil)
 }
 
+// SetCrowdControlLevel sets the crowd control level of the post, overriding the subreddit's.
+// Crowd control collapses comments from people who aren't trusted members of the subreddit yet.
+// The level must be between 0 (off) and 3 (strictest).
+func (s *PostService) SetCrowdControlLevel(ctx context.Context, id string, level int) (*Response, error) {
+	if level < 0 || level > 3 {
+		return nil, errors.New("level: must be between 0 and 3")
+	}
+
+	path := "api/update_crowd_control_level"
+
+	form := url.Values{}
+	form.Set("api_type", "json")
+	form.Set("id", id)
+	form.Set("level", strconv.Itoa(level))
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // LoadMoreComments retrieves more comments that were left out when initially fetching the post.
 func (s *PostService) LoadMoreComments(ctx context.Context, pc *PostAndComments) (*Response, error) {
 	if pc == nil {
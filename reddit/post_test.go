@@ -1,6 +1,7 @@
 package reddit
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -15,7 +16,6 @@ var expectedPostAndComments = &PostAndComments{
 		ID:      "testpost",
 		FullID:  "t3_testpost",
 		Created: &Timestamp{time.Date(2020, 7, 18, 10, 26, 7, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/testpost/test/",
 		URL:       "https://www.reddit.com/r/test/comments/testpost/test/",
@@ -42,14 +42,14 @@ var expectedPostAndComments = &PostAndComments{
 			ID:      "testc1",
 			FullID:  "t1_testc1",
 			Created: &Timestamp{time.Date(2020, 7, 18, 10, 31, 59, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 			ParentID:  "t3_testpost",
 			Permalink: "/r/test/comments/testpost/test/testc1/",
 
-			Body:     "Hi",
-			Author:   "testuser",
-			AuthorID: "t2_testuser",
+			Body:            "Hi",
+			Author:          "testuser",
+			AuthorID:        "t2_testuser",
+			AuthorFlairType: "text",
 
 			SubredditName:         "test",
 			SubredditNamePrefixed: "r/test",
@@ -69,14 +69,14 @@ var expectedPostAndComments = &PostAndComments{
 						ID:      "testc2",
 						FullID:  "t1_testc2",
 						Created: &Timestamp{time.Date(2020, 7, 18, 10, 32, 28, 0, time.UTC)},
-						Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 						ParentID:  "t1_testc1",
 						Permalink: "/r/test/comments/testpost/test/testc2/",
 
-						Body:     "Hello",
-						Author:   "testuser",
-						AuthorID: "t2_testuser",
+						Body:            "Hello",
+						Author:          "testuser",
+						AuthorID:        "t2_testuser",
+						AuthorFlairType: "text",
 
 						SubredditName:         "test",
 						SubredditNamePrefixed: "r/test",
@@ -85,6 +85,8 @@ var expectedPostAndComments = &PostAndComments{
 						Score:            1,
 						Controversiality: 0,
 
+						Depth: 1,
+
 						PostID: "t3_testpost",
 
 						IsSubmitter: true,
@@ -136,7 +138,6 @@ var expectedPost2 = &Post{
 	ID:      "i2gvs1",
 	FullID:  "t3_i2gvs1",
 	Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 37, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	Permalink: "/r/test/comments/i2gvs1/this_is_a_title/",
 	URL:       "http://example.com",
@@ -163,7 +164,6 @@ var expectedPostDuplicates = []*Post{
 		ID:      "8kbs85",
 		FullID:  "t3_8kbs85",
 		Created: &Timestamp{time.Date(2018, 5, 18, 9, 10, 18, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/8kbs85/test/",
 		URL:       "http://example.com",
@@ -183,12 +183,12 @@ var expectedPostDuplicates = []*Post{
 
 		Author:   "GarlicoinAccount",
 		AuthorID: "t2_d2v1r90",
+		Archived: true,
 	},
 	{
 		ID:      "le1tc",
 		FullID:  "t3_le1tc",
 		Created: &Timestamp{time.Date(2011, 10, 16, 13, 26, 40, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/le1tc/test_to_see_if_this_fixes_the_problem_of_my_likes/",
 		URL:       "http://www.example.com",
@@ -208,6 +208,7 @@ var expectedPostDuplicates = []*Post{
 
 		Author:   "prog101",
 		AuthorID: "t2_8dyo",
+		Archived: true,
 	},
 }
 
@@ -259,6 +260,59 @@ func TestPostService_Duplicates(t *testing.T) {
 	require.Equal(t, "t3_le1tc", resp.After)
 }
 
+func TestPostService_CrosspostGraph(t *testing.T) {
+	client, mux := setup(t)
+
+	for _, name := range []string{"child", "parent", "repost"} {
+		blob, err := readFileContents(fmt.Sprintf("../testdata/post/crosspost-graph-%s.json", name))
+		require.NoError(t, err)
+
+		mux.HandleFunc(fmt.Sprintf("/duplicates/%s1", name), func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodGet, r.Method)
+
+			form := url.Values{}
+			form.Set("limit", "100")
+
+			err := r.ParseForm()
+			require.NoError(t, err)
+			require.Equal(t, form, r.Form)
+
+			fmt.Fprint(w, blob)
+		})
+	}
+
+	graph, _, err := client.Post.CrosspostGraph(ctx, "child1", nil)
+	require.NoError(t, err)
+	require.Equal(t, "t3_child1", graph.Root.FullID)
+	require.Len(t, graph.Posts, 3)
+	require.Equal(t, "t3_parent1", graph.Posts["t3_child1"].CrosspostParentID)
+	require.Equal(t, []*CrosspostGraphEdge{
+		{From: "t3_child1", To: "t3_parent1", Kind: CrosspostGraphEdgeCrosspost},
+		{From: "t3_parent1", To: "t3_repost1", Kind: CrosspostGraphEdgeDuplicate},
+		{From: "t3_child1", To: "t3_repost1", Kind: CrosspostGraphEdgeDuplicate},
+	}, graph.Edges)
+	require.Equal(t, []*Post{graph.Posts["t3_child1"]}, graph.Crossposts("t3_parent1"))
+}
+
+func TestPostService_CrosspostGraph_MaxRequests(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/crosspost-graph-child.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/duplicates/child1", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	graph, _, err := client.Post.CrosspostGraph(ctx, "t3_child1", &CrosspostGraphOptions{MaxRequests: 1})
+	require.NoError(t, err)
+	require.Len(t, graph.Posts, 2)
+	require.Equal(t, []*CrosspostGraphEdge{
+		{From: "t3_child1", To: "t3_parent1", Kind: CrosspostGraphEdgeCrosspost},
+	}, graph.Edges)
+}
+
 func TestPostService_SubmitText(t *testing.T) {
 	client, mux := setup(t)
 
@@ -293,6 +347,61 @@ func TestPostService_SubmitText(t *testing.T) {
 	require.Equal(t, expectedSubmittedPost, submittedPost)
 }
 
+func TestPostService_SubmitText_FlairRequired(t *testing.T) {
+	client, mux := setup(t)
+	err := WithPostFlairRequirementCheck(map[string]string{"Test": "flair1"})(client)
+	require.NoError(t, err)
+
+	blob, err := readFileContents("../testdata/post/submit.json")
+	require.NoError(t, err)
+
+	flairsBlob, err := readFileContents("../testdata/flair/post-flairs.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/test/post_requirements", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"is_flair_required": true}`)
+	})
+	mux.HandleFunc("/api/v1/other/post_requirements", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"is_flair_required": true}`)
+	})
+	mux.HandleFunc("/r/other/api/link_flair_v2", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, flairsBlob)
+	})
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "self")
+		form.Set("sr", "test")
+		form.Set("title", "Test Title")
+		form.Set("flair_id", "flair1")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	submittedPost, _, err := client.Post.SubmitText(ctx, SubmitTextRequest{
+		Subreddit: "test",
+		Title:     "Test Title",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSubmittedPost, submittedPost)
+
+	_, _, err = client.Post.SubmitText(ctx, SubmitTextRequest{
+		Subreddit: "other",
+		Title:     "Test Title",
+	})
+	require.Equal(t, &FlairRequiredError{Subreddit: "other", Choices: expectedPostFlairs}, err)
+}
+
 func TestPostService_SubmitLink(t *testing.T) {
 	client, mux := setup(t)
 
@@ -331,6 +440,81 @@ func TestPostService_SubmitLink(t *testing.T) {
 	require.Equal(t, expectedSubmittedPost, submittedPost)
 }
 
+func TestPostService_SubmitTextToProfile(t *testing.T) {
+	client, mux := setup(t)
+	client.Username = "testuser"
+
+	blob, err := readFileContents("../testdata/post/submit.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "self")
+		form.Set("submit_type", "profile")
+		form.Set("sr", "u_testuser")
+		form.Set("title", "Test Title")
+		form.Set("text", "Test Text")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	submittedPost, _, err := client.Post.SubmitTextToProfile(ctx, SubmitTextRequest{
+		Subreddit: "ignored",
+		Title:     "Test Title",
+		Text:      "Test Text",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSubmittedPost, submittedPost)
+}
+
+func TestPostService_SubmitLinkToProfile(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/submit.json")
+	require.NoError(t, err)
+
+	infoBlob, err := readFileContents("../testdata/account/info.json")
+	require.NoError(t, err)
+
+	// the client has no username, so it's looked up
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, infoBlob)
+	})
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "link")
+		form.Set("submit_type", "profile")
+		form.Set("sr", "u_v_95")
+		form.Set("title", "Test Title")
+		form.Set("url", "https://www.example.com")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	submittedPost, _, err := client.Post.SubmitLinkToProfile(ctx, SubmitLinkRequest{
+		Title: "Test Title",
+		URL:   "https://www.example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSubmittedPost, submittedPost)
+}
+
 func TestPostService_Edit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -478,6 +662,27 @@ func TestPostService_Unspoiler(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostService_SetFlair(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/selectflair", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("link", "t3_test")
+		form.Set("flair_template_id", "id123")
+		form.Set("text", "text123")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Post.SetFlair(ctx, "t3_test", "id123", "text123")
+	require.NoError(t, err)
+}
+
 func TestPostService_Sticky(t *testing.T) {
 	client, mux := setup(t)
 
@@ -796,6 +1001,30 @@ func TestPostService_DisableContestMode(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostService_SetCrowdControlLevel(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/update_crowd_control_level", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("id", "t3_test")
+		form.Set("level", "2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Post.SetCrowdControlLevel(ctx, "t3_test", 4)
+	require.EqualError(t, err, "level: must be between 0 and 3")
+
+	resp, err := client.Post.SetCrowdControlLevel(ctx, "t3_test", 2)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestPostService_LoadMoreReplies(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1133,3 +1362,224 @@ func TestPostService_Report(t *testing.T) {
 	_, err := client.Post.Report(ctx, "t3_test", "test reason")
 	require.NoError(t, err)
 }
+
+func TestPost_UnmarshalJSON_Removal(t *testing.T) {
+	post := new(Post)
+	err := json.Unmarshal([]byte(`{
+		"id": "test",
+		"banned_by": "modusername",
+		"banned_at_utc": 1595067967,
+		"removed_by": null,
+		"removed_by_category": "moderator",
+		"removal_reason": "legacy reason",
+		"mod_reason_title": "Rule 1"
+	}`), post)
+	require.NoError(t, err)
+	require.Equal(t, &Removal{
+		By:       "modusername",
+		Category: "moderator",
+		Reason:   "Rule 1",
+		At:       &Timestamp{time.Date(2020, 7, 18, 10, 26, 7, 0, time.UTC)},
+	}, post.Removal)
+
+	post = new(Post)
+	err = json.Unmarshal([]byte(`{"id": "test", "banned_by": true, "removed_by_category": "automod_filtered"}`), post)
+	require.NoError(t, err)
+	require.Equal(t, &Removal{Category: "automod_filtered"}, post.Removal)
+
+	post = new(Post)
+	err = json.Unmarshal([]byte(`{"id": "test", "banned_by": null, "removed_by_category": null}`), post)
+	require.NoError(t, err)
+	require.Equal(t, "test", post.ID)
+	require.Nil(t, post.Removal)
+}
+
+func TestPost_UnmarshalJSON_Gallery(t *testing.T) {
+	post := new(Post)
+	err := json.Unmarshal([]byte(`{
+		"id": "test",
+		"is_gallery": true,
+		"gallery_data": {
+			"items": [
+				{"media_id": "img2", "id": 2, "caption": "second"},
+				{"media_id": "img1", "id": 1, "outbound_url": "https://example.com"}
+			]
+		},
+		"media_metadata": {
+			"img1": {"status": "valid", "e": "Image", "m": "image/jpg", "s": {"y": 100, "x": 200, "u": "https://preview.redd.it/img1.jpg"}, "id": "img1"},
+			"img2": {"status": "valid", "e": "Image", "m": "image/png", "s": {"y": 300, "x": 400, "u": "https://preview.redd.it/img2.png"}, "id": "img2"}
+		}
+	}`), post)
+	require.NoError(t, err)
+	require.True(t, post.IsGallery)
+	require.Equal(t, []*GalleryItem{
+		{ID: 2, MediaID: "img2", Caption: "second"},
+		{ID: 1, MediaID: "img1", OutboundURL: "https://example.com"},
+	}, post.Gallery)
+	require.Len(t, post.MediaMetadata, 2)
+	require.Equal(t, "https://preview.redd.it/img2.png", post.MediaMetadata[post.Gallery[0].MediaID].URL())
+}
+
+func TestPost_UnmarshalJSON_Poll(t *testing.T) {
+	post := new(Post)
+	err := json.Unmarshal([]byte(`{
+		"id": "test",
+		"poll_data": {
+			"voting_end_timestamp": 1601139600000,
+			"total_vote_count": 12,
+			"user_selection": "2",
+			"options": [
+				{"id": "1", "text": "yes", "vote_count": 5},
+				{"id": "2", "text": "no", "vote_count": 7}
+			]
+		}
+	}`), post)
+	require.NoError(t, err)
+	require.Equal(t, &Poll{
+		Options: []*PollOption{
+			{ID: "1", Text: "yes", VoteCount: Int(5)},
+			{ID: "2", Text: "no", VoteCount: Int(7)},
+		},
+		TotalVotes:    Int(12),
+		VotingEnds:    &Timestamp{time.Date(2020, 9, 26, 17, 0, 0, 0, time.UTC)},
+		UserSelection: "2",
+	}, post.Poll)
+}
+
+func TestPost_UnmarshalJSON_Crosspost(t *testing.T) {
+	post := new(Post)
+	err := json.Unmarshal([]byte(`{
+		"id": "test",
+		"crosspost_parent": "t3_parent",
+		"crosspost_parent_list": [
+			{
+				"id": "parent",
+				"name": "t3_parent",
+				"secure_media": {
+					"type": "youtube.com",
+					"oembed": {
+						"type": "video",
+						"title": "test video",
+						"provider_name": "YouTube",
+						"provider_url": "https://www.youtube.com/",
+						"html": "<iframe></iframe>",
+						"width": 356,
+						"height": 200,
+						"thumbnail_url": "https://i.ytimg.com/vi/test/hqdefault.jpg",
+						"thumbnail_width": 480,
+						"thumbnail_height": 360
+					}
+				}
+			}
+		]
+	}`), post)
+	require.NoError(t, err)
+	require.Len(t, post.Crossposts, 1)
+	require.Equal(t, "t3_parent", post.Crossposts[0].FullID)
+	require.Equal(t, &PostMedia{
+		Type: "youtube.com",
+		OEmbed: &OEmbed{
+			Type:            "video",
+			Title:           "test video",
+			ProviderName:    "YouTube",
+			ProviderURL:     "https://www.youtube.com/",
+			HTML:            "<iframe></iframe>",
+			Width:           356,
+			Height:          200,
+			ThumbnailURL:    "https://i.ytimg.com/vi/test/hqdefault.jpg",
+			ThumbnailWidth:  480,
+			ThumbnailHeight: 360,
+		},
+	}, post.Crossposts[0].Media)
+
+	post = new(Post)
+	err = json.Unmarshal([]byte(`{"id": "test", "crosspost_parent_list": []}`), post)
+	require.NoError(t, err)
+	require.Nil(t, post.Crossposts)
+}
+
+func TestPost_UnmarshalJSON_Edited(t *testing.T) {
+	post := new(Post)
+	err := json.Unmarshal([]byte(`{"id": "test", "created_utc": 1595468000.0, "edited": false}`), post)
+	require.NoError(t, err)
+	require.Equal(t, &Timestamp{time.Date(2020, 7, 23, 1, 33, 20, 0, time.UTC)}, post.Created)
+	require.Nil(t, post.Edited)
+
+	post = new(Post)
+	err = json.Unmarshal([]byte(`{"id": "test", "edited": 1595468564.0}`), post)
+	require.NoError(t, err)
+	require.Equal(t, &Timestamp{time.Date(2020, 7, 23, 1, 42, 44, 0, time.UTC)}, post.Edited)
+
+	comment := new(Comment)
+	err = json.Unmarshal([]byte(`{"id": "test", "edited": false}`), comment)
+	require.NoError(t, err)
+	require.Nil(t, comment.Edited)
+}
+
+func TestPost_UnmarshalJSON_UserState(t *testing.T) {
+	post := new(Post)
+	err := json.Unmarshal([]byte(`{"id": "test", "likes": false, "saved": true, "hidden": true, "clicked": true}`), post)
+	require.NoError(t, err)
+	require.Equal(t, Bool(false), post.Likes)
+	require.True(t, post.Saved)
+	require.True(t, post.Hidden)
+	require.True(t, post.Clicked)
+
+	post = new(Post)
+	err = json.Unmarshal([]byte(`{"id": "test", "likes": null, "saved": false, "hidden": false, "clicked": false}`), post)
+	require.NoError(t, err)
+	require.Nil(t, post.Likes)
+	require.False(t, post.Hidden)
+}
+
+func TestPost_UnmarshalJSON_Awards(t *testing.T) {
+	blob, err := readFileContents("../testdata/post/awards.json")
+	require.NoError(t, err)
+
+	root := new(thing)
+	err = json.Unmarshal([]byte(blob), root)
+	require.NoError(t, err)
+
+	l, ok := root.Listing()
+	require.True(t, ok)
+	require.Len(t, l.Posts(), 1)
+	require.Len(t, l.Comments(), 2)
+
+	require.Equal(t, []*Award{
+		{
+			ID:          "gid_2",
+			Name:        "Gold",
+			Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.",
+			CoinPrice:   500,
+			IconURL:     "https://www.redditstatic.com/gold/awards/icon/gold_512.png",
+			Type:        "global",
+			IsEnabled:   true,
+			Count:       2,
+		},
+		{
+			ID:          "award_2f8b0a85-2a1e-4b0f-8e6c-3f6e0c1d2b7a",
+			Name:        "Gopher",
+			Description: "A community award.",
+			CoinPrice:   300,
+			IconURL:     "https://i.redd.it/award_images/t5_2qh1i/gopher_award.png",
+			Type:        "community",
+			SubredditID: "t5_2qh1i",
+			IsEnabled:   true,
+			Count:       1,
+		},
+	}, l.Posts()[0].Awards)
+
+	require.Equal(t, []*Award{
+		{
+			ID:          "gid_1",
+			Name:        "Silver",
+			Description: "Shows the Silver Award... and that's it.",
+			CoinPrice:   100,
+			IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+			Type:        "global",
+			IsEnabled:   true,
+			Count:       1,
+		},
+	}, l.Comments()[0].Awards)
+	require.Nil(t, l.Comments()[1].Awards)
+}
@@ -0,0 +1,84 @@
+package reddit
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type skipCacheKey struct{}
+
+// WithoutCache returns a copy of ctx that makes the request made with it skip the client's
+// response cache, if one is configured with WithCache, and get a fresh response from Reddit.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipCacheKey{}, true)
+}
+
+// responseCache keeps the responses to GET requests for things that rarely change, such as
+// the about pages of subreddits and users, and serves them again until they expire.
+type responseCache struct {
+	responses *lruCache
+}
+
+func newResponseCache(size int, ttl time.Duration) *responseCache {
+	return &responseCache{responses: newLRUCache(size, ttl)}
+}
+
+// cacheablePathSuffixes are the endpoints whose responses are cached, e.g. r/{subreddit}/about.
+var cacheablePathSuffixes = []string{"/about", "/about/rules", "/about/moderators"}
+
+func cacheable(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	path := strings.TrimSuffix(req.URL.Path, ".json")
+	for _, suffix := range cacheablePathSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// do returns a copy of the response kept for the request, if any, or sends it with fn.
+func (c *responseCache) do(ctx context.Context, req *http.Request, fn func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if !cacheable(req) {
+		return fn(req)
+	}
+	key := req.URL.String()
+
+	if skip, _ := ctx.Value(skipCacheKey{}).(bool); !skip {
+		if v, ok := c.responses.get(key); ok {
+			return v.(*keptResponse).response(req, nil), nil
+		}
+	}
+
+	resp, err := fn(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	// The rate limit headers describe the client's limit at the time of this request, so they
+	// aren't kept. A cache hit doesn't use up any of the limit and mustn't report a stale one.
+	kept := &keptResponse{resp: withoutRateLimitHeaders(resp), body: body}
+	c.responses.add(key, kept)
+
+	return kept.response(req, resp.Header), nil
+}
+
+func withoutRateLimitHeaders(resp *http.Response) *http.Response {
+	stripped := *resp
+	stripped.Header = resp.Header.Clone()
+	stripped.Header.Del(headerRateLimitRemaining)
+	stripped.Header.Del(headerRateLimitUsed)
+	stripped.Header.Del(headerRateLimitReset)
+	return &stripped
+}
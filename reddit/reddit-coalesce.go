@@ -0,0 +1,115 @@
+package reddit
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// coalescer makes sure that only one identical GET request is in flight at a time.
+// Callers that make the same request while it is in flight wait for it and share its
+// response instead of making their own.
+type coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+type coalescedCall struct {
+	// closed once the request has finished
+	done chan struct{}
+	// cancels the request once every caller waiting for it has given up
+	cancel  context.CancelFunc
+	waiters int
+
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+func newCoalescer() *coalescer {
+	return &coalescer{calls: make(map[string]*coalescedCall)}
+}
+
+func coalesceKey(req *http.Request) (string, bool) {
+	if req.Method != http.MethodGet {
+		return "", false
+	}
+	return req.Method + " " + req.URL.String(), true
+}
+
+// do executes fn for the request, unless an identical request is already in flight,
+// in which case it waits for that one to finish and returns a copy of its response.
+// The request is sent with a context of its own rather than that of the caller who
+// started it, so one caller giving up doesn't fail it for the others; each caller stops
+// waiting when its own ctx is done. It is canceled once no one is waiting for it.
+func (g *coalescer) do(ctx context.Context, req *http.Request, fn func(context.Context) (*http.Response, error)) (*http.Response, error) {
+	key, ok := coalesceKey(req)
+	if !ok {
+		return fn(ctx)
+	}
+
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.Background())
+		call = &coalescedCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+		go g.run(callCtx, key, call, fn)
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.response(req)
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Callers arriving from now on start a new request rather than join a canceled one.
+			g.forget(key, call)
+			call.cancel()
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (g *coalescer) run(ctx context.Context, key string, call *coalescedCall, fn func(context.Context) (*http.Response, error)) {
+	defer call.cancel()
+
+	call.resp, call.err = fn(ctx)
+	if call.err == nil {
+		call.body, call.err = ioutil.ReadAll(call.resp.Body)
+		call.resp.Body.Close()
+	}
+
+	g.mu.Lock()
+	g.forget(key, call)
+	g.mu.Unlock()
+	close(call.done)
+}
+
+// forget removes the call from the ones in flight, unless it was already replaced.
+// g.mu must be held.
+func (g *coalescer) forget(key string, call *coalescedCall) {
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
+
+// response returns a copy of the call's response with its own body, so that
+// every caller can read and close it independently.
+func (c *coalescedCall) response(req *http.Request) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	resp := *c.resp
+	resp.Header = c.resp.Header.Clone()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(c.body))
+	resp.Request = req
+	return &resp, nil
+}
@@ -0,0 +1,91 @@
+package reddit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+)
+
+// etagCache keeps the responses to GET requests that came with an ETag or a Last-Modified
+// header, so that the requests can be made conditional the next time they are made, and the
+// kept response served again if Reddit answers with 304 Not Modified.
+type etagCache struct {
+	responses *lruCache
+}
+
+// keptResponse is a response kept in a cache, with its body read.
+type keptResponse struct {
+	resp *http.Response
+	body []byte
+}
+
+func newETagCache(size int) *etagCache {
+	return &etagCache{responses: newLRUCache(size, 0)}
+}
+
+// do sends the request with fn, adding the validators of the response kept for it, if any.
+func (c *etagCache) do(req *http.Request, fn func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return fn(req)
+	}
+	key := req.URL.String()
+
+	var entry *keptResponse
+	if v, ok := c.responses.get(key); ok {
+		entry = v.(*keptResponse)
+
+		// don't modify the caller's request
+		req = req.Clone(req.Context())
+		if etag := entry.resp.Header.Get(headerETag); etag != "" {
+			req.Header.Set(headerIfNoneMatch, etag)
+		}
+		if lastModified := entry.resp.Header.Get(headerLastModified); lastModified != "" {
+			req.Header.Set(headerIfModifiedSince, lastModified)
+		}
+	}
+
+	resp, err := fn(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return entry.response(req, resp.Header), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get(headerETag) == "" && resp.Header.Get(headerLastModified) == "") {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	entry = &keptResponse{resp: resp, body: body}
+	c.responses.add(key, entry)
+
+	return entry.response(req, nil), nil
+}
+
+// response returns a copy of the kept response with its own body. The headers in header,
+// e.g. the rate limit ones of a 304 response, replace the kept ones.
+func (e *keptResponse) response(req *http.Request, header http.Header) *http.Response {
+	resp := *e.resp
+	resp.Header = e.resp.Header.Clone()
+	for k, v := range header {
+		resp.Header[k] = v
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(e.body))
+	resp.Request = req
+	return &resp
+}
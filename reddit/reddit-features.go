@@ -0,0 +1,104 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Feature is a part of the Reddit API that Reddit has changed or disabled before, or might.
+type Feature string
+
+// Features that Client.Supports can check.
+const (
+	// The api/v1/{subreddit}/post_requirements endpoint, used by SubredditService.PostRequirements.
+	FeaturePostRequirements Feature = "post_requirements"
+	// The v2 flair endpoints, used by FlairService.GetUserFlairs and FlairService.GetPostFlairs.
+	FeatureFlairV2 Feature = "flair_v2"
+	// The api/v1/{subreddit}/removal_reasons endpoint, used by ModerationService.RemovalReasons.
+	FeatureRemovalReasons Feature = "removal_reasons"
+	// The api/v1/collections endpoints, used by CollectionService.
+	FeatureCollections Feature = "collections"
+	// The api/user_data_by_account_ids endpoint, used by UserService.GetMultipleByID.
+	FeatureUserDataByAccountIDs Feature = "user_data_by_account_ids"
+)
+
+// featureProbes are harmless GET requests to the endpoints behind each feature.
+// They use r/announcements, which every account can see.
+var featureProbes = map[Feature]string{
+	FeaturePostRequirements:     "api/v1/announcements/post_requirements",
+	FeatureFlairV2:              "r/announcements/api/link_flair_v2",
+	FeatureRemovalReasons:       "api/v1/announcements/removal_reasons",
+	FeatureCollections:          "api/v1/collections/subreddit_collections?sr_fullname=t5_2r0ij",
+	FeatureUserDataByAccountIDs: "api/user_data_by_account_ids?ids=t2_1",
+}
+
+// featureSupport caches the results of feature probes.
+type featureSupport struct {
+	mu        sync.Mutex
+	supported map[Feature]bool
+}
+
+// Supports reports whether Reddit still serves the endpoints behind the feature, so that
+// applications can degrade gracefully when it doesn't. The first call for a feature probes
+// the endpoint with a request; the result is cached for the lifetime of the client.
+// The endpoint counts as unsupported if it responds with 404 Not Found, 410 Gone or
+// 501 Not Implemented. Any other response, including errors such as 403 Forbidden, means
+// the endpoint is there. If the probe can't get a response, or gets one that says nothing
+// about the endpoint, such as 401 Unauthorized, 429 Too Many Requests or another 5xx server
+// error, the error is returned and nothing is cached.
+func (c *Client) Supports(ctx context.Context, feature Feature) (bool, error) {
+	path, ok := featureProbes[feature]
+	if !ok {
+		return false, fmt.Errorf("feature %q: unknown", feature)
+	}
+
+	c.features.mu.Lock()
+	supported, ok := c.features.supported[feature]
+	c.features.mu.Unlock()
+	if ok {
+		return supported, nil
+	}
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.Do(ctx, req, nil)
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) || errors.Is(err, ErrCredentialsInvalid) {
+		return false, err
+	}
+	if resp == nil || resp.Response == nil {
+		if err == nil {
+			err = fmt.Errorf("feature %q: no response", feature)
+		}
+		return false, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusTooManyRequests:
+		// says nothing about the endpoint
+		return false, err
+	case http.StatusNotFound, http.StatusGone, http.StatusNotImplemented:
+		supported = false
+	default:
+		if resp.StatusCode >= http.StatusInternalServerError {
+			// probably a transient outage
+			return false, err
+		}
+		supported = true
+	}
+
+	c.features.mu.Lock()
+	if c.features.supported == nil {
+		c.features.supported = make(map[Feature]bool)
+	}
+	c.features.supported[feature] = supported
+	c.features.mu.Unlock()
+
+	return supported, nil
+}
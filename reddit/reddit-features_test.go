@@ -0,0 +1,76 @@
+package reddit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Supports(t *testing.T) {
+	client, mux := setup(t)
+
+	var counter int
+	mux.HandleFunc("/api/v1/announcements/post_requirements", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		counter++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/r/announcements/api/link_flair_v2", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		counter++
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/api/user_data_by_account_ids", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		counter++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.Supports(ctx, Feature("nope"))
+	require.EqualError(t, err, `feature "nope": unknown`)
+
+	supported, err := client.Supports(ctx, FeaturePostRequirements)
+	require.NoError(t, err)
+	require.False(t, supported)
+
+	supported, err = client.Supports(ctx, FeatureFlairV2)
+	require.NoError(t, err)
+	require.True(t, supported)
+
+	// results are cached
+	supported, err = client.Supports(ctx, FeaturePostRequirements)
+	require.NoError(t, err)
+	require.False(t, supported)
+	require.Equal(t, 2, counter)
+
+	// a 401 says nothing about the endpoint, so it isn't cached
+	_, err = client.Supports(ctx, FeatureUserDataByAccountIDs)
+	require.Error(t, err)
+	_, err = client.Supports(ctx, FeatureUserDataByAccountIDs)
+	require.Error(t, err)
+	require.Equal(t, 4, counter)
+}
+
+func TestClient_Supports_ServerError(t *testing.T) {
+	client, mux := setup(t)
+
+	var counter int
+	mux.HandleFunc("/r/announcements/api/link_flair_v2", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		if counter == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+
+	// an outage during the first probe isn't cached
+	_, err := client.Supports(ctx, FeatureFlairV2)
+	require.Error(t, err)
+
+	supported, err := client.Supports(ctx, FeatureFlairV2)
+	require.NoError(t, err)
+	require.True(t, supported)
+	require.Equal(t, 2, counter)
+}
@@ -0,0 +1,30 @@
+package reddit
+
+import (
+	"context"
+	"strings"
+)
+
+type skipFooterKey struct{}
+
+// WithoutDisclosureFooter returns a copy of ctx that stops the client's disclosure footer,
+// if one is configured with WithDisclosureFooter, from being added to the comment or
+// message sent with it.
+func WithoutDisclosureFooter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipFooterKey{}, true)
+}
+
+// withFooter appends the client's disclosure footer to text as its own paragraph.
+// Text that already ends with the footer is left alone.
+func (c *Client) withFooter(ctx context.Context, text string) string {
+	if c.disclosureFooter == "" {
+		return text
+	}
+	if skip, _ := ctx.Value(skipFooterKey{}).(bool); skip {
+		return text
+	}
+	if strings.HasSuffix(text, c.disclosureFooter) {
+		return text
+	}
+	return text + "\n\n" + c.disclosureFooter
+}
@@ -0,0 +1,186 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// keepAlivePath is requested by Client.KeepAlive. It's public and its response is small.
+const keepAlivePath = "api/v1/scopes"
+
+// keepAliveTimeout is how long a keepalive can take. A connection that doesn't answer by then
+// has most likely been dropped, and waiting longer would hold up Suspend and other requests.
+const keepAliveTimeout = 10 * time.Second
+
+// connHealth tracks when the client's connections last worked, so that ones that have
+// probably been dropped while idle (e.g. by a NAT or proxy) can be closed before they're used.
+type connHealth struct {
+	mu       sync.Mutex
+	lastUsed time.Time
+
+	// If greater than 0, idle connections are closed before a request if none has
+	// succeeded for this long. Set by WithConnectionHealthCheck.
+	staleAfter time.Duration
+}
+
+// before closes the client's idle connections if they have gone stale.
+func (h *connHealth) before(client *http.Client) {
+	h.mu.Lock()
+	stale := h.staleAfter > 0 && !h.lastUsed.IsZero() && time.Since(h.lastUsed) > h.staleAfter
+	if stale {
+		h.lastUsed = time.Time{}
+	}
+	h.mu.Unlock()
+
+	if stale {
+		closeIdleConnections(client.Transport)
+	}
+}
+
+// after records the outcome of a request. If it timed out, the client's idle connections
+// are closed, since they probably lead to the same dead end.
+func (h *connHealth) after(client *http.Client, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err == nil {
+		h.lastUsed = time.Now()
+		return
+	}
+
+	var netErr net.Error
+	if h.staleAfter > 0 && errors.As(err, &netErr) && netErr.Timeout() {
+		h.lastUsed = time.Time{}
+		closeIdleConnections(client.Transport)
+	}
+}
+
+// usedWithin reports whether a request succeeded within the last d.
+func (h *connHealth) usedWithin(d time.Duration) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return !h.lastUsed.IsZero() && time.Since(h.lastUsed) < d
+}
+
+// closeIdleConnections closes the idle connections of the transport at the bottom of rt,
+// looking through the transports the client wraps it in, since they don't pass it on.
+func closeIdleConnections(rt http.RoundTripper) {
+	for {
+		switch t := rt.(type) {
+		case nil:
+			rt = http.DefaultTransport
+		case *userAgentTransport:
+			rt = t.base()
+		case *authorizationTransport:
+			rt = t.base()
+		case *oauth2.Transport:
+			if t.Base == nil {
+				rt = http.DefaultTransport
+			} else {
+				rt = t.Base
+			}
+		case interface{ CloseIdleConnections() }:
+			t.CloseIdleConnections()
+			return
+		default:
+			return
+		}
+	}
+}
+
+// KeepAlive sends a lightweight request every interval in which the client made no other
+// successful request, so that its connections are still usable when it's used again, e.g. by
+// the next poll of a stream. A keepalive that fails or takes longer than 10 seconds closes
+// the client's idle connections. Keepalives count towards Reddit's rate limit, so interval
+// shouldn't be too short; a minute is usually enough to keep a NAT mapping open. No
+// keepalives are sent while the client is suspended. Call the returned function to stop,
+// which also cancels a keepalive in flight.
+func (c *Client) KeepAlive(interval time.Duration) (stop func(), err error) {
+	if interval <= 0 {
+		return nil, errors.New("interval: must be greater than 0")
+	}
+
+	ticker := time.NewTicker(interval)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			ticker.Stop()
+			cancel()
+		})
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if c.health.usedWithin(interval) {
+				continue
+			}
+			err := c.keepAlive(ctx)
+			if err != nil && err != ErrSuspended && ctx.Err() == nil {
+				closeIdleConnections(c.client.Transport)
+			}
+		}
+	}()
+
+	return stop, nil
+}
+
+// keepAlive sends the keepalive request. It bypasses Do, so that it doesn't affect the
+// client's rate limit or auth failure tracking, but like any other request it isn't sent
+// while the client is suspended, waits for the concurrency limit and is logged.
+func (c *Client) keepAlive(ctx context.Context) error {
+	if !c.gate.enter() {
+		return ErrSuspended
+	}
+	defer c.gate.leave()
+
+	ctx, cancel := context.WithTimeout(ctx, keepAliveTimeout)
+	defer cancel()
+
+	req, err := c.NewRequest(http.MethodGet, keepAlivePath, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	if c.limiter != nil {
+		release, err := c.limiter.acquire(req.Context(), req)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	if err != nil {
+		c.logRequest(req, nil, time.Since(start), err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// The body has to be read for the connection to be reused.
+	_, err = io.Copy(ioutil.Discard, resp.Body)
+	c.logRequest(req, newResponse(resp), time.Since(start), err)
+	if err != nil {
+		return err
+	}
+
+	c.health.after(c.client, nil)
+	return nil
+}
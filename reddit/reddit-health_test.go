@@ -0,0 +1,137 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type closeCountingTransport struct {
+	http.Transport
+	closed int32
+}
+
+func (t *closeCountingTransport) CloseIdleConnections() {
+	atomic.AddInt32(&t.closed, 1)
+	t.Transport.CloseIdleConnections()
+}
+
+func setupHealth(t *testing.T, mux *http.ServeMux, opts ...Opt) (*Client, *closeCountingTransport) {
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	transport := new(closeCountingTransport)
+	opts = append(opts, WithBaseURL(server.URL), WithHTTPClient(&http.Client{Transport: transport}))
+	client, err := NewClient(Credentials{}, opts...)
+	require.NoError(t, err)
+	client.InitializeUserAgent("user_agent_value")
+	client.InitializeAccessToken("access_token_value")
+	return client, transport
+}
+
+func TestWithConnectionHealthCheck(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithConnectionHealthCheck(0))
+	require.EqualError(t, err, "stale after: must be greater than 0")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	client, transport := setupHealth(t, mux, WithConnectionHealthCheck(time.Minute))
+
+	_, _, err = client.Account.Info(ctx)
+	require.NoError(t, err)
+	_, _, err = client.Account.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), atomic.LoadInt32(&transport.closed))
+
+	// the connections have been idle for too long, so they're closed before the next request
+	client.health.lastUsed = time.Now().Add(-time.Hour)
+	_, _, err = client.Account.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&transport.closed))
+}
+
+func TestClient_KeepAlive(t *testing.T) {
+	var requests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/scopes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{}`))
+	})
+	client, transport := setupHealth(t, mux)
+
+	_, err := client.KeepAlive(0)
+	require.EqualError(t, err, "interval: must be greater than 0")
+
+	stop, err := client.KeepAlive(time.Millisecond * 10)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+	stop()
+	stop()
+
+	require.NotZero(t, atomic.LoadInt32(&requests))
+	require.Equal(t, int32(0), atomic.LoadInt32(&transport.closed))
+	require.True(t, client.health.usedWithin(time.Second))
+}
+
+func TestClient_KeepAlive_Suspended(t *testing.T) {
+	var requests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/scopes", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{}`))
+	})
+	client, transport := setupHealth(t, mux)
+
+	err := client.Suspend(ctx)
+	require.NoError(t, err)
+
+	stop, err := client.KeepAlive(time.Millisecond * 10)
+	require.NoError(t, err)
+	defer stop()
+	time.Sleep(time.Millisecond * 100)
+
+	require.Zero(t, atomic.LoadInt32(&requests))
+	// the connections aren't given up on either
+	require.Equal(t, int32(0), atomic.LoadInt32(&transport.closed))
+
+	client.Resume()
+	time.Sleep(time.Millisecond * 100)
+	require.NotZero(t, atomic.LoadInt32(&requests))
+}
+
+func TestClient_KeepAlive_Stop(t *testing.T) {
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/scopes", func(w http.ResponseWriter, r *http.Request) {
+		// the connection is a dead end: the keepalive never gets a response
+		close(started)
+		<-r.Context().Done()
+		close(canceled)
+	})
+	client, _ := setupHealth(t, mux)
+
+	stop, err := client.KeepAlive(time.Millisecond * 10)
+	require.NoError(t, err)
+	<-started
+
+	stop()
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("the keepalive wasn't canceled")
+	}
+
+	// it no longer holds up suspending the client
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	require.NoError(t, client.Suspend(ctx))
+}
@@ -0,0 +1,78 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// concurrencyLimiter caps the number of requests in flight, overall and per path family.
+type concurrencyLimiter struct {
+	all chan struct{}
+
+	mu           sync.Mutex
+	maxPerFamily int
+	families     map[string]chan struct{}
+}
+
+func newConcurrencyLimiter(max, maxPerFamily int) *concurrencyLimiter {
+	l := &concurrencyLimiter{maxPerFamily: maxPerFamily, families: make(map[string]chan struct{})}
+	if max > 0 {
+		l.all = make(chan struct{}, max)
+	}
+	return l
+}
+
+// pathFamily returns the first segment of the request's path after the subreddit or user it's
+// for, if any, e.g. comments for r/golang/comments/abc123, or api for api/v1/me.
+func pathFamily(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) > 2 && (segments[0] == "r" || segments[0] == "user" || segments[0] == "u") {
+		segments = segments[2:]
+	}
+	return strings.TrimSuffix(segments[0], ".json")
+}
+
+func (l *concurrencyLimiter) family(name string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sem, ok := l.families[name]
+	if !ok {
+		sem = make(chan struct{}, l.maxPerFamily)
+		l.families[name] = sem
+	}
+	return sem
+}
+
+// acquire waits for the request to be allowed in flight, unless ctx is done first.
+// If it returns a nil error, the returned function must be called once the request is done.
+func (l *concurrencyLimiter) acquire(ctx context.Context, req *http.Request) (release func(), err error) {
+	var sems []chan struct{}
+	if l.maxPerFamily > 0 {
+		sems = append(sems, l.family(pathFamily(req)))
+	}
+	if l.all != nil {
+		sems = append(sems, l.all)
+	}
+
+	release = func() {
+		for _, sem := range sems {
+			<-sem
+		}
+	}
+
+	for i, sem := range sems {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for _, acquired := range sems[:i] {
+				<-acquired
+			}
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
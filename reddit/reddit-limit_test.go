@@ -0,0 +1,92 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathFamily(t *testing.T) {
+	for path, family := range map[string]string{
+		"/r/golang/comments/abc123/test": "comments",
+		"/r/golang/about.json":           "about",
+		"/user/test/submitted":           "submitted",
+		"/api/v1/me":                     "api",
+		"/comments/abc123":               "comments",
+		"/r/golang":                      "r",
+	} {
+		req := &http.Request{URL: &url.URL{Path: path}}
+		require.Equal(t, family, pathFamily(req), path)
+	}
+}
+
+func TestWithConcurrencyLimit(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithConcurrencyLimit(-1, 0))
+	require.EqualError(t, err, "concurrency limit: cannot be negative")
+
+	c, err := NewClient(Credentials{}, WithConcurrencyLimit(0, 0))
+	require.NoError(t, err)
+	require.Nil(t, c.limiter)
+
+	var inFlight, maxInFlight, maxMeInFlight, meInFlight int32
+	track := func(counter, max *int32) func() {
+		n := atomic.AddInt32(counter, 1)
+		for {
+			m := atomic.LoadInt32(max)
+			if n <= m || atomic.CompareAndSwapInt32(max, m, n) {
+				break
+			}
+		}
+		return func() { atomic.AddInt32(counter, -1) }
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		defer track(&inFlight, &maxInFlight)()
+		defer track(&meInFlight, &maxMeInFlight)()
+		time.Sleep(time.Millisecond * 20)
+		w.Write([]byte(`{}`))
+	})
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		defer track(&inFlight, &maxInFlight)()
+		time.Sleep(time.Millisecond * 20)
+		w.Write([]byte(`{}`))
+	}
+	mux.HandleFunc("/r/test/about", handler)
+	mux.HandleFunc("/r/test/hot", handler)
+	client, _ := setupHealth(t, mux, WithConcurrencyLimit(2, 1))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		for _, path := range []string{"api/v1/me", "r/test/about", "r/test/hot"} {
+			wg.Add(1)
+			go func(path string) {
+				defer wg.Done()
+				req, err := client.NewRequest(http.MethodGet, path, nil)
+				require.NoError(t, err)
+				_, err = client.Do(ctx, req, nil)
+				require.NoError(t, err)
+			}(path)
+		}
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), maxMeInFlight)
+	require.Equal(t, int32(2), maxInFlight)
+
+	// a request waiting for a slot gives up when its context is done
+	release, err := client.limiter.acquire(ctx, &http.Request{URL: &url.URL{Path: "/api/v1/me"}})
+	require.NoError(t, err)
+	defer release()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	_, _, err = client.Account.Info(timeoutCtx)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,70 @@
+package reddit
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Logger logs the requests made by a client configured with WithLogger.
+// A *slog.Logger satisfies it, as do loggers with the same methods.
+type Logger interface {
+	Info(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// redactedQueryParams are the query parameters whose values are left out of logged URLs.
+var redactedQueryParams = []string{"access_token", "refresh_token", "password", "client_secret", "code"}
+
+// redactURL returns a copy of u without the values of the query parameters that are secrets.
+func redactURL(u *url.URL) *url.URL {
+	redacted := *u
+	if u.RawQuery == "" {
+		return &redacted
+	}
+
+	query := u.Query()
+	for _, param := range redactedQueryParams {
+		if _, ok := query[param]; ok {
+			query.Set(param, "REDACTED")
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return &redacted
+}
+
+// logRequest logs the outcome of the request, if the client has a logger. The credentials the
+// request was authenticated with are never logged, since they're only in its headers.
+func (c *Client) logRequest(req *http.Request, resp *Response, latency time.Duration, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	u := redactURL(req.URL)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = &url.Error{Op: urlErr.Op, URL: u.String(), Err: urlErr.Err}
+		}
+		c.logger.Error("reddit: request failed",
+			"method", req.Method,
+			"path", u.Path,
+			"latency", latency,
+			"error", err,
+		)
+		return
+	}
+
+	c.logger.Info("reddit: request",
+		"method", req.Method,
+		"path", u.Path,
+		"query", u.RawQuery,
+		"status", resp.StatusCode,
+		"latency", latency,
+		"request_id", resp.RequestID,
+		"ratelimit_remaining", resp.Rate.Remaining,
+		"ratelimit_used", resp.Rate.Used,
+		"ratelimit_reset", resp.Rate.Reset,
+	)
+}
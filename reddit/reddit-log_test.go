@@ -0,0 +1,86 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingLogger struct {
+	entries []string
+	args    [][]interface{}
+}
+
+func (l *recordingLogger) Info(msg string, args ...interface{}) {
+	l.entries = append(l.entries, "INFO "+msg)
+	l.args = append(l.args, args)
+}
+
+func (l *recordingLogger) Error(msg string, args ...interface{}) {
+	l.entries = append(l.entries, "ERROR "+msg)
+	l.args = append(l.args, args)
+}
+
+// attr returns the value logged for the key in the entry.
+func (l *recordingLogger) attr(entry int, key string) interface{} {
+	args := l.args[entry]
+	for i := 0; i+1 < len(args); i += 2 {
+		if args[i] == key {
+			return args[i+1]
+		}
+	}
+	return nil
+}
+
+func TestRedactURL(t *testing.T) {
+	u, err := url.Parse("https://oauth.reddit.com/api/v1/test?limit=1&access_token=secret&password=hunter2")
+	require.NoError(t, err)
+	require.Equal(t, "https://oauth.reddit.com/api/v1/test?access_token=REDACTED&limit=1&password=REDACTED", redactURL(u).String())
+	require.Equal(t, "limit=1&access_token=secret&password=hunter2", u.RawQuery)
+}
+
+func TestWithLogger(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithLogger(nil))
+	require.EqualError(t, err, "logger: cannot be nil")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "abc123")
+		w.Header().Set(headerRateLimitRemaining, "599")
+		w.Header().Set(headerRateLimitUsed, "1")
+		w.Write([]byte(`{}`))
+	})
+
+	logger := new(recordingLogger)
+	client, _ := setupHealth(t, mux, WithLogger(logger))
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/me?access_token=secret", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"INFO reddit: request"}, logger.entries)
+	require.Equal(t, http.MethodGet, logger.attr(0, "method"))
+	require.Equal(t, "/api/v1/me", logger.attr(0, "path"))
+	require.Equal(t, "access_token=REDACTED", logger.attr(0, "query"))
+	require.Equal(t, http.StatusOK, logger.attr(0, "status"))
+	require.Equal(t, "abc123", logger.attr(0, "request_id"))
+	require.Equal(t, 599, logger.attr(0, "ratelimit_remaining"))
+	require.Equal(t, 1, logger.attr(0, "ratelimit_used"))
+
+	// requests that fail are logged as errors, without secrets
+	client.BaseURL.Host = "127.0.0.1:1"
+	req, err = client.NewRequest(http.MethodGet, "api/v1/me?access_token=secret", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.Error(t, err)
+
+	require.Len(t, logger.entries, 2)
+	require.Equal(t, "ERROR reddit: request failed", logger.entries[1])
+	logged := fmt.Sprint(logger.attr(1, "error"))
+	require.NotContains(t, logged, "secret")
+	require.Contains(t, logged, "access_token=REDACTED")
+}
@@ -0,0 +1,35 @@
+package reddit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	c := newLRUCache(2, 0)
+
+	c.add("a", 1)
+	c.add("b", 2)
+	_, ok := c.get("a")
+	require.True(t, ok)
+
+	// b is the least recently used, so it's evicted
+	c.add("c", 3)
+	_, ok = c.get("b")
+	require.False(t, ok)
+
+	v, ok := c.get("a")
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+	v, ok = c.get("c")
+	require.True(t, ok)
+	require.Equal(t, 3, v)
+
+	c = newLRUCache(2, time.Millisecond)
+	c.add("a", 1)
+	time.Sleep(time.Millisecond * 5)
+	_, ok = c.get("a")
+	require.False(t, ok)
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"time"
 )
 
 // Opt is used to further configure a client upon initialization.
@@ -70,6 +72,175 @@ func WithTokenURL(u string) Opt {
 	}
 }
 
+// WithRequestCoalescing makes identical GET requests (same path and query) that are made
+// concurrently share a single round trip to Reddit. The callers that join an in-flight
+// request receive a copy of its response, or its error, including one caused by the
+// cancellation of the context of the request that was sent.
+func WithRequestCoalescing() Opt {
+	return func(c *Client) error {
+		c.coalescer = newCoalescer()
+		return nil
+	}
+}
+
+// WithCache makes the client keep the responses to up to size GET requests for things that
+// rarely change, namely the about pages, rules and moderators of subreddits and the about pages
+// of users, and return them again instead of asking Reddit for them until they're older than ttl.
+// Use WithoutCache on the context of a call to get a fresh response instead.
+func WithCache(size int, ttl time.Duration) Opt {
+	return func(c *Client) error {
+		if size <= 0 {
+			return errors.New("cache size: must be greater than 0")
+		}
+		if ttl <= 0 {
+			return errors.New("cache ttl: must be greater than 0")
+		}
+		c.cache = newResponseCache(size, ttl)
+		return nil
+	}
+}
+
+// WithConditionalRequests makes the client keep the responses to up to size GET requests that
+// came with an ETag or Last-Modified header, and make those requests conditional the next time.
+// If Reddit answers that the resource hasn't changed (304 Not Modified), the kept response is
+// returned instead, which spares repeatedly fetching the same listing or thing.
+func WithConditionalRequests(size int) Opt {
+	return func(c *Client) error {
+		if size <= 0 {
+			return errors.New("conditional request cache size: must be greater than 0")
+		}
+		c.etags = newETagCache(size)
+		return nil
+	}
+}
+
+// WithRawJSON makes the client send raw_json=1 with every request, so that Reddit returns text
+// fields such as the bodies and titles of posts and comments as they were written, instead of
+// with &, < and > escaped as &amp;, &lt; and &gt;.
+func WithRawJSON() Opt {
+	return func(c *Client) error {
+		c.rawJSON = true
+		return nil
+	}
+}
+
+// WithConcurrencyLimit caps the number of requests the client has in flight at once to max,
+// and the number of those to the same family of paths to maxPerFamily. A path's family is its
+// first segment after the subreddit or user it's for, if any, e.g. comments for
+// r/golang/comments/abc123, or api for api/v1/me. A request over a limit waits for one in flight
+// to finish, or for its context to be done. A limit of 0 isn't applied.
+func WithConcurrencyLimit(max, maxPerFamily int) Opt {
+	return func(c *Client) error {
+		if max < 0 || maxPerFamily < 0 {
+			return errors.New("concurrency limit: cannot be negative")
+		}
+		if max == 0 && maxPerFamily == 0 {
+			return nil
+		}
+		c.limiter = newConcurrencyLimiter(max, maxPerFamily)
+		return nil
+	}
+}
+
+// WithLogger makes the client log every request it makes with the logger, e.g. a *slog.Logger:
+// its method, path, status and latency, and the rate limit state after it. Requests that fail
+// are logged as errors. Access tokens, passwords and other secrets are left out of the logs.
+func WithLogger(logger Logger) Opt {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger: cannot be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithRawThingJSON makes the client keep the JSON Reddit sent for the posts, comments and
+// subreddits it returns, in their RawJSON field, so that fields the library doesn't model yet
+// can be read from it. This costs the memory of the JSON, and the time to find it in responses.
+func WithRawThingJSON() Opt {
+	return func(c *Client) error {
+		c.rawThingJSON = true
+		return nil
+	}
+}
+
+// WithDisclosureFooter appends footer, as its own paragraph, to every comment submitted and
+// private message sent with the client, e.g. "^(I am a bot. Contact my owner.)".
+// Use WithoutDisclosureFooter on the context of a call to send it without the footer.
+func WithDisclosureFooter(footer string) Opt {
+	return func(c *Client) error {
+		c.disclosureFooter = footer
+		return nil
+	}
+}
+
+// WithMaxAuthFailures makes the client give up on its credentials once n requests in a row
+// have been rejected because of them (a 401, a 403 with USER_REQUIRED, or the token endpoint
+// refusing to give an access token), and return ErrCredentialsInvalid for every request instead
+// of sending it, until Client.ResetAuthFailures or Client.InitializeAccessToken is called.
+// If n is 0, the default, the client never gives up.
+func WithMaxAuthFailures(n int) Opt {
+	return func(c *Client) error {
+		if n < 0 {
+			return errors.New("max auth failures: cannot be negative")
+		}
+		c.maxAuthFailures = n
+		return nil
+	}
+}
+
+// WithPostFlairRequirementCheck makes the client check whether a subreddit requires post flair
+// before submitting a post without one to it. If it does, the flair template id from defaults
+// (keyed by subreddit name) is applied to the post; if there is none, a *FlairRequiredError
+// listing the subreddit's post flairs is returned instead of submitting the post.
+// Defaults may be nil. Checking costs an extra request for every post submitted without flair.
+func WithPostFlairRequirementCheck(defaults map[string]string) Opt {
+	return func(c *Client) error {
+		c.defaultPostFlairs = make(map[string]string, len(defaults))
+		for subreddit, flairID := range defaults {
+			c.defaultPostFlairs[strings.ToLower(subreddit)] = flairID
+		}
+		return nil
+	}
+}
+
+// WithReplyGuard makes CommentService.Submit check the thread it's replying in against the guard
+// before submitting, returning ErrReplyTooDeep, ErrThreadLocked or ErrThreadArchived if the reply
+// is refused.
+func WithReplyGuard(guard ReplyGuard) Opt {
+	return func(c *Client) error {
+		c.replyGuard = &guard
+		return nil
+	}
+}
+
+// WithUserCache sets how many users UserService.MeetsThreshold keeps cached, and for how long.
+// The defaults are 1000 users for 10 minutes. If ttl is 0 or less, users stay cached until evicted.
+func WithUserCache(size int, ttl time.Duration) Opt {
+	return func(c *Client) error {
+		if size <= 0 {
+			return errors.New("user cache size: must be greater than 0")
+		}
+		c.userCache = newLRUCache(size, ttl)
+		return nil
+	}
+}
+
+// WithConnectionHealthCheck makes the client close its idle connections before a request if
+// none has succeeded for staleAfter, and after a request times out, so that the next request
+// opens a new connection instead of waiting on one that was silently dropped while idle
+// (common behind NATs). Client.KeepAlive can keep the connections from going idle instead.
+func WithConnectionHealthCheck(staleAfter time.Duration) Opt {
+	return func(c *Client) error {
+		if staleAfter <= 0 {
+			return errors.New("stale after: must be greater than 0")
+		}
+		c.health.staleAfter = staleAfter
+		return nil
+	}
+}
+
 // FromEnv configures the client with values from environment variables.
 // Supported environment variables:
 // GO_REDDIT_CLIENT_ID to set the client's id.
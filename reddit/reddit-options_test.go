@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -52,6 +53,71 @@ func TestWithTokenURL(t *testing.T) {
 	require.Equal(t, tokenURL, c.TokenURL.String())
 }
 
+func TestWithMaxAuthFailures(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithMaxAuthFailures(-1))
+	require.EqualError(t, err, "max auth failures: cannot be negative")
+
+	c, err := NewClient(Credentials{})
+	require.NoError(t, err)
+	require.Equal(t, 0, c.maxAuthFailures)
+
+	c, err = NewClient(Credentials{}, WithMaxAuthFailures(5))
+	require.NoError(t, err)
+	require.Equal(t, 5, c.maxAuthFailures)
+}
+
+func TestWithUserCache(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithUserCache(0, time.Minute))
+	require.EqualError(t, err, "user cache size: must be greater than 0")
+
+	c, err := NewClient(Credentials{}, WithUserCache(10, time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 10, c.userCache.size)
+	require.Equal(t, time.Minute, c.userCache.ttl)
+}
+
+func TestWithCache(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithCache(0, time.Minute))
+	require.EqualError(t, err, "cache size: must be greater than 0")
+
+	_, err = NewClient(Credentials{}, WithCache(10, 0))
+	require.EqualError(t, err, "cache ttl: must be greater than 0")
+
+	c, err := NewClient(Credentials{}, WithCache(10, time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 10, c.cache.responses.size)
+	require.Equal(t, time.Minute, c.cache.responses.ttl)
+}
+
+func TestWithConditionalRequests(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithConditionalRequests(0))
+	require.EqualError(t, err, "conditional request cache size: must be greater than 0")
+
+	c, err := NewClient(Credentials{}, WithConditionalRequests(10))
+	require.NoError(t, err)
+	require.Equal(t, 10, c.etags.responses.size)
+}
+
+func TestWithRawJSON(t *testing.T) {
+	c, err := NewClient(Credentials{})
+	require.NoError(t, err)
+
+	req, err := c.NewRequest(http.MethodGet, "r/test/hot?limit=2", nil)
+	require.NoError(t, err)
+	require.Equal(t, "limit=2", req.URL.RawQuery)
+
+	c, err = NewClient(Credentials{}, WithRawJSON())
+	require.NoError(t, err)
+
+	req, err = c.NewRequest(http.MethodGet, "r/test/hot?limit=2", nil)
+	require.NoError(t, err)
+	require.Equal(t, url.Values{"limit": {"2"}, "raw_json": {"1"}}, req.URL.Query())
+
+	req, err = c.NewJSONRequest(http.MethodPost, "api/v2/gold/gild", nil)
+	require.NoError(t, err)
+	require.Equal(t, "raw_json=1", req.URL.RawQuery)
+}
+
 func TestFromEnv(t *testing.T) {
 	os.Setenv("GO_REDDIT_CLIENT_ID", "id1")
 	defer os.Unsetenv("GO_REDDIT_CLIENT_ID")
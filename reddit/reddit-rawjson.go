@@ -0,0 +1,101 @@
+package reddit
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+var (
+	postType      = reflect.TypeOf(Post{})
+	commentType   = reflect.TypeOf(Comment{})
+	subredditType = reflect.TypeOf(Subreddit{})
+)
+
+// collectThingJSON finds the things (objects with a kind and data) in the JSON value, and adds
+// the data of the posts, comments and subreddits among them to raws, keyed by their full ID.
+func collectThingJSON(data json.RawMessage, raws map[string]json.RawMessage) {
+	switch {
+	case len(data) == 0:
+		return
+	case data[0] == '[':
+		var values []json.RawMessage
+		if json.Unmarshal(data, &values) != nil {
+			return
+		}
+		for _, v := range values {
+			collectThingJSON(v, raws)
+		}
+	case data[0] == '{':
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(data, &fields) != nil {
+			return
+		}
+
+		var kind string
+		_ = json.Unmarshal(fields["kind"], &kind)
+		if kind == kindPost || kind == kindComment || kind == kindSubreddit {
+			var thing struct {
+				FullID string `json:"name"`
+			}
+			if json.Unmarshal(fields["data"], &thing) == nil && thing.FullID != "" {
+				raws[thing.FullID] = fields["data"]
+			}
+		}
+
+		for _, v := range fields {
+			collectThingJSON(v, raws)
+		}
+	}
+}
+
+// attachRawJSON sets the RawJSON field of the posts, comments and subreddits in v, which was
+// decoded from data, to the data Reddit sent for them.
+func attachRawJSON(data []byte, v interface{}) {
+	raws := make(map[string]json.RawMessage)
+	collectThingJSON(data, raws)
+	if len(raws) == 0 {
+		return
+	}
+	attachRawJSONToValue(reflect.ValueOf(v), raws, make(map[uintptr]bool))
+}
+
+func attachRawJSONToValue(v reflect.Value, raws map[string]json.RawMessage, visited map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || visited[v.Pointer()] {
+			return
+		}
+		visited[v.Pointer()] = true
+		if l, ok := v.Interface().(*listing); ok {
+			// the things of a listing are unexported, so they're not reached through its fields
+			attachRawJSONToValue(reflect.ValueOf(l.things), raws, visited)
+			return
+		}
+		attachRawJSONToValue(v.Elem(), raws, visited)
+	case reflect.Interface:
+		if !v.IsNil() {
+			attachRawJSONToValue(v.Elem(), raws, visited)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			attachRawJSONToValue(v.Index(i), raws, visited)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			attachRawJSONToValue(iter.Value(), raws, visited)
+		}
+	case reflect.Struct:
+		switch v.Type() {
+		case postType, commentType, subredditType:
+			if raw, ok := raws[v.FieldByName("FullID").String()]; ok && v.CanSet() {
+				v.FieldByName("RawJSON").SetBytes(append(json.RawMessage(nil), raw...))
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				attachRawJSONToValue(v.Field(i), raws, visited)
+			}
+		}
+	}
+}
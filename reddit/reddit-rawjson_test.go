@@ -0,0 +1,45 @@
+package reddit
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRawThingJSON(t *testing.T) {
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/comments/abc123", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(blob))
+	})
+	mux.HandleFunc("/r/test/new", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"kind": "Listing", "data": {"children": [{"kind": "t3", "data": {"name": "t3_test", "unmodelled": 1}}]}}`))
+	})
+
+	// without the option, the JSON isn't kept
+	client, _ := setupHealth(t, mux)
+	pc, _, err := client.Post.Get(ctx, "abc123")
+	require.NoError(t, err)
+	require.Nil(t, pc.Post.RawJSON)
+
+	client, _ = setupHealth(t, mux, WithRawThingJSON())
+	pc, _, err = client.Post.Get(ctx, "abc123")
+	require.NoError(t, err)
+
+	var post map[string]interface{}
+	require.NoError(t, json.Unmarshal(pc.Post.RawJSON, &post))
+	require.Equal(t, pc.Post.FullID, post["name"])
+
+	var reply map[string]interface{}
+	require.NoError(t, json.Unmarshal(pc.Comments[0].Replies.Comments[0].RawJSON, &reply))
+	require.Equal(t, "t1_testc2", reply["name"])
+	require.Equal(t, float64(1), reply["depth"])
+
+	posts, _, err := client.Subreddit.NewPosts(ctx, "test", nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "t3_test", "unmodelled": 1}`, string(posts[0].RawJSON))
+}
@@ -0,0 +1,86 @@
+package reddit
+
+import (
+	"context"
+	"sync"
+)
+
+// trafficGate lets requests through unless the client is suspended, and keeps count of the
+// requests in flight so that suspending can wait for them to finish.
+type trafficGate struct {
+	mu        sync.Mutex
+	suspended bool
+	inFlight  int
+	// Closed once no requests are in flight while the client is suspended.
+	drained chan struct{}
+}
+
+// enter reports whether a request may be sent. If it may, leave must be called once it's done.
+func (g *trafficGate) enter() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.suspended {
+		return false
+	}
+	g.inFlight++
+	return true
+}
+
+func (g *trafficGate) leave() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.inFlight--
+	if g.inFlight == 0 && g.drained != nil {
+		select {
+		case <-g.drained:
+		default:
+			close(g.drained)
+		}
+	}
+}
+
+// Suspend stops the client from sending requests: from then on, every call made with it returns
+// ErrSuspended straight away, until Resume is called. Requests already in flight are let finish;
+// Suspend waits for them to, unless ctx is done first, in which case it returns ctx's error
+// (the client stays suspended either way).
+func (c *Client) Suspend(ctx context.Context) error {
+	g := &c.gate
+
+	g.mu.Lock()
+	g.suspended = true
+	if g.drained == nil {
+		g.drained = make(chan struct{})
+		if g.inFlight == 0 {
+			close(g.drained)
+		}
+	}
+	drained := g.drained
+	g.mu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Resume lets the client send requests again after Suspend.
+func (c *Client) Resume() {
+	g := &c.gate
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.suspended = false
+	g.drained = nil
+}
+
+// Suspended reports whether the client has been suspended with Suspend, and not resumed since.
+func (c *Client) Suspended() bool {
+	c.gate.mu.Lock()
+	defer c.gate.mu.Unlock()
+	return c.gate.suspended
+}
@@ -0,0 +1,65 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Suspend(t *testing.T) {
+	release := make(chan struct{})
+	entered := make(chan struct{}, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("wait") != "" {
+			entered <- struct{}{}
+			<-release
+		}
+		w.Write([]byte(`{}`))
+	})
+	client, _ := setupHealth(t, mux)
+
+	// a request in flight when the client is suspended is let finish
+	inFlight := make(chan error)
+	go func() {
+		req, err := client.NewRequest(http.MethodGet, "api/v1/me?wait=1", nil)
+		require.NoError(t, err)
+		_, err = client.Do(ctx, req, nil)
+		inFlight <- err
+	}()
+	<-entered
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	err := client.Suspend(timeoutCtx)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.True(t, client.Suspended())
+
+	_, _, err = client.Account.Info(ctx)
+	require.Equal(t, ErrSuspended, err)
+
+	suspended := make(chan error)
+	go func() {
+		suspended <- client.Suspend(ctx)
+	}()
+
+	close(release)
+	require.NoError(t, <-inFlight)
+	require.NoError(t, <-suspended)
+
+	client.Resume()
+	require.False(t, client.Suspended())
+
+	_, _, err = client.Account.Info(ctx)
+	require.NoError(t, err)
+
+	// with nothing in flight, suspending returns straight away
+	err = client.Suspend(ctx)
+	require.NoError(t, err)
+	_, _, err = client.Account.Info(ctx)
+	require.Equal(t, ErrSuspended, err)
+}
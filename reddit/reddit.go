@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -27,6 +28,9 @@ const (
 	defaultBaseURLReadonly = "https://reddit.com"
 	defaultTokenURL        = "https://www.reddit.com/api/v1/access_token"
 
+	defaultUserCacheSize = 1000
+	defaultUserCacheTTL  = time.Minute * 10
+
 	mediaTypeJSON = "application/json"
 	mediaTypeForm = "application/x-www-form-urlencoded"
 
@@ -38,6 +42,8 @@ const (
 	headerRateLimitRemaining = "x-ratelimit-remaining"
 	headerRateLimitUsed      = "x-ratelimit-used"
 	headerRateLimitReset     = "x-ratelimit-reset"
+
+	headerRequestID = "x-reddit-request-id"
 )
 
 var defaultClient, _ = NewReadonlyClient()
@@ -71,6 +77,12 @@ type Client struct {
 	rateMu sync.Mutex
 	rate   Rate
 
+	// Number of consecutive responses rejecting the client's credentials,
+	// and how many are allowed before giving up on them. 0 means no limit.
+	authMu          sync.Mutex
+	authFailures    int
+	maxAuthFailures int
+
 	isSync bool
 
 	ID       string
@@ -94,6 +106,7 @@ type Client struct {
 	Listings   *ListingsService
 	LiveThread *LiveThreadService
 	Message    *MessageService
+	Modmail    *ModmailService
 	Moderation *ModerationService
 	Multi      *MultiService
 	Post       *PostService
@@ -106,6 +119,49 @@ type Client struct {
 	oauth2Transport *oauth2.Transport
 
 	onRequestCompleted RequestCompletionCallback
+
+	// If set, identical concurrent GET requests share a single round trip.
+	coalescer *coalescer
+
+	// If set, responses to GET requests for things that rarely change are kept for a while.
+	cache *responseCache
+
+	// If set, GET requests are made conditional on the responses kept for them.
+	etags *etagCache
+
+	// If set, decoded posts, comments and subreddits keep the JSON they were decoded from.
+	rawThingJSON bool
+
+	// If set, raw_json=1 is sent with every request so text fields aren't HTML-escaped.
+	rawJSON bool
+
+	// Markdown appended to comments and private messages sent by the client.
+	disclosureFooter string
+
+	// If set, submissions without flair are checked against the subreddit's post requirements.
+	// Maps lowercase subreddit names to the id of the flair applied when flair is required.
+	defaultPostFlairs map[string]string
+
+	// If set, CommentService.Submit refuses replies that the guard forbids.
+	replyGuard *ReplyGuard
+
+	// Users looked up by UserService.MeetsThreshold, keyed by lowercase username.
+	userCache *lruCache
+
+	// Results of Supports.
+	features featureSupport
+
+	// When the client's connections last worked, and when they count as stale.
+	health connHealth
+
+	// Stops requests while the client is suspended.
+	gate trafficGate
+
+	// If set, caps the number of requests in flight.
+	limiter *concurrencyLimiter
+
+	// If set, every request is logged.
+	logger Logger
 }
 
 func (c *Client) InitializeClientIdClientSecret(clientId, clientSecret string) {
@@ -126,6 +182,16 @@ func (c *Client) InitializeAccessToken(accessToken string) {
 
 	oauthTransport := oauthTransport(c, accessToken)
 	c.client.Transport = oauthTransport
+	c.ResetAuthFailures()
+}
+
+// ResetAuthFailures forgets the responses that rejected the client's credentials so far, so
+// that a client that has given up on them, and returns ErrCredentialsInvalid, makes requests
+// again. InitializeAccessToken calls it, as a new token deserves a new chance.
+func (c *Client) ResetAuthFailures() {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	c.authFailures = 0
 }
 
 // OnRequestCompleted sets the client's request completion callback.
@@ -137,7 +203,12 @@ func newClient() *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 	tokenURL, _ := url.Parse(defaultTokenURL)
 
-	client := &Client{client: &http.Client{}, BaseURL: baseURL, TokenURL: tokenURL}
+	client := &Client{
+		client:    &http.Client{},
+		BaseURL:   baseURL,
+		TokenURL:  tokenURL,
+		userCache: newLRUCache(defaultUserCacheSize, defaultUserCacheTTL),
+	}
 
 	client.Account = &AccountService{client: client}
 	client.Collection = &CollectionService{client: client}
@@ -147,6 +218,7 @@ func newClient() *Client {
 	client.Listings = &ListingsService{client: client}
 	client.LiveThread = &LiveThreadService{client: client}
 	client.Message = &MessageService{client: client}
+	client.Modmail = &ModmailService{client: client}
 	client.Moderation = &ModerationService{client: client}
 	client.Multi = &MultiService{client: client}
 	client.Stream = &StreamService{client: client}
@@ -279,6 +351,17 @@ func (c *Client) appendJSONExtensionToRequestURLPath(req *http.Request) {
 	req.URL.Path += ".json"
 }
 
+// Without raw_json=1, Reddit returns text fields with &, < and > HTML-escaped.
+func (c *Client) addRawJSONToRequestURLQuery(req *http.Request) {
+	if !c.rawJSON {
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("raw_json", "1")
+	req.URL.RawQuery = query.Encode()
+}
+
 // UserAgent returns the client's user agent.
 func (c *Client) UserAgent() string {
 	if c.userAgent == "" {
@@ -311,6 +394,7 @@ func (c *Client) NewRequest(method string, path string, form url.Values) (*http.
 	}
 
 	c.appendJSONExtensionToRequestURLPath(req)
+	c.addRawJSONToRequestURLQuery(req)
 	req.Header.Add(headerContentType, mediaTypeForm)
 	req.Header.Add(headerAccept, mediaTypeJSON)
 
@@ -341,6 +425,7 @@ func (c *Client) NewJSONRequest(method string, path string, body interface{}) (*
 	}
 
 	c.appendJSONExtensionToRequestURLPath(req)
+	c.addRawJSONToRequestURLQuery(req)
 	req.Header.Add(headerContentType, mediaTypeJSON)
 	req.Header.Add(headerAccept, mediaTypeJSON)
 
@@ -357,14 +442,30 @@ type Response struct {
 	// Rate limit information.
 	Rate Rate
 
+	// The ID Reddit gave the request, worth quoting when reporting a problem to Reddit.
+	RequestID string
+	// How long it took to get the response, from sending the request to receiving its headers.
+	Duration time.Duration
+
 	// JobId async job id.
 	JobId string
 }
 
+// ItemResult is the outcome of one item of a batch operation. Batch operations return
+// their results in the same order as their inputs, so that only the failed items need
+// to be retried.
+type ItemResult struct {
+	// Identifies the item, e.g. a username or a full ID.
+	Input string
+	// Nil if the operation succeeded for this item.
+	Err error
+}
+
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := Response{Response: r}
 	response.Rate = parseRate(r)
+	response.RequestID = r.Header.Get(headerRequestID)
 	return &response
 }
 
@@ -398,6 +499,23 @@ func parseRate(r *http.Response) Rate {
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if c.credentialsInvalid() {
+		return nil, ErrCredentialsInvalid
+	}
+
+	if !c.gate.enter() {
+		return nil, ErrSuspended
+	}
+	defer c.gate.leave()
+
+	if c.limiter != nil {
+		release, err := c.limiter.acquire(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
 	if err := c.checkRateLimitBeforeDo(req); err != nil {
 		return &Response{
 			Response: err.Response,
@@ -405,8 +523,13 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		}, err
 	}
 
-	resp, err := DoRequestWithClient(ctx, c.client, req)
+	start := time.Now()
+	resp, err := c.doRequest(ctx, req)
 	if err != nil {
+		c.logRequest(req, nil, time.Since(start), err)
+		if isTokenFailure(err) && c.trackAuthFailure(true) {
+			return nil, ErrCredentialsInvalid
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
@@ -416,12 +539,20 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}
 
 	response := newResponse(resp)
-
-	c.rateMu.Lock()
-	c.rate = response.Rate
-	c.rateMu.Unlock()
+	response.Duration = time.Since(start)
+	c.logRequest(req, response, response.Duration, nil)
+
+	// Responses served from the cache have no rate limit headers and leave the rate as it is.
+	if resp.Header.Get(headerRateLimitRemaining) != "" {
+		c.rateMu.Lock()
+		c.rate = response.Rate
+		c.rateMu.Unlock()
+	}
 
 	err = CheckResponse(resp)
+	if c.trackAuthFailure(isAuthFailure(resp)) {
+		return response, ErrCredentialsInvalid
+	}
 	if err != nil {
 		return response, err
 	}
@@ -443,6 +574,9 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 			if err != nil {
 				return response, err
 			}
+			if c.rawThingJSON {
+				attachRawJSON(buffer, v)
+			}
 			//重新给response.Body赋值
 			response.Body = io.NopCloser(bytes.NewReader(buffer))
 		}
@@ -464,6 +598,101 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, nil
 }
 
+func (c *Client) doRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
+	c.health.before(c.client)
+	resp, err := c.sendRequest(ctx, req)
+	c.health.after(c.client, err)
+	return resp, err
+}
+
+func (c *Client) sendRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
+	sendWithContext := func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return DoRequestWithClient(ctx, c.client, req)
+	}
+	if c.etags != nil {
+		sendUnconditional := sendWithContext
+		sendWithContext = func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return c.etags.do(req, func(req *http.Request) (*http.Response, error) {
+				return sendUnconditional(ctx, req)
+			})
+		}
+	}
+
+	if c.coalescer != nil {
+		sendAlone := sendWithContext
+		sendWithContext = func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return c.coalescer.do(ctx, req, func(ctx context.Context) (*http.Response, error) {
+				return sendAlone(ctx, req)
+			})
+		}
+	}
+
+	send := func(req *http.Request) (*http.Response, error) {
+		return sendWithContext(ctx, req)
+	}
+
+	if c.cache == nil {
+		return send(req)
+	}
+	return c.cache.do(ctx, req, send)
+}
+
+func (c *Client) credentialsInvalid() bool {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.maxAuthFailures > 0 && c.authFailures >= c.maxAuthFailures
+}
+
+// trackAuthFailure counts consecutive requests that were rejected because of the client's
+// credentials, resetting the count if this one wasn't. It reports whether the count has
+// reached the limit set with WithMaxAuthFailures.
+func (c *Client) trackAuthFailure(failed bool) bool {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if c.maxAuthFailures == 0 {
+		return false
+	}
+	if !failed {
+		c.authFailures = 0
+		return false
+	}
+
+	c.authFailures++
+	return c.authFailures >= c.maxAuthFailures
+}
+
+// isTokenFailure reports whether the request failed because the token endpoint rejected the
+// client's credentials when getting an access token for it.
+func isTokenFailure(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) || retrieveErr.Response == nil {
+		return false
+	}
+	switch retrieveErr.Response.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized:
+		return true
+	}
+	return false
+}
+
+// isAuthFailure reports whether Reddit rejected the request because of the client's
+// credentials rather than the request itself.
+func isAuthFailure(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusForbidden:
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return false
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		return bytes.Contains(data, []byte("USER_REQUIRED"))
+	}
+	return false
+}
+
 func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
 	c.rateMu.Lock()
 	rate := c.rate
@@ -587,6 +816,13 @@ func CheckResponse(r *http.Response) error {
 		r.Body = io.NopCloser(bytes.NewReader(data))
 		return nil
 	} else {
+		if c == http.StatusForbidden {
+			if err := quarantinedError(r, data); err != nil {
+				r.Body = io.NopCloser(bytes.NewReader(data))
+				return err
+			}
+		}
+
 		if strings.Contains(strings.ToLower(string(data)), "<title>blocked</title>") {
 			r.Body = io.NopCloser(bytes.NewReader(data))
 			return &ProxyErrorResponse{
@@ -666,7 +902,17 @@ func (c *Client) getListing(ctx context.Context, path string, opts interface{})
 	return l, resp, nil
 }
 
+func (c *Client) getThings(ctx context.Context, path string, opts interface{}) ([]Thing, *Response, error) {
+	l, resp, err := c.getListing(ctx, path, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Things(), resp, nil
+}
+
 // ListOptions specifies the optional parameters to various API calls that return a listing.
+// Listings are paginated the same way everywhere: set After to the After of the previous page's
+// *Response to get the next page. Options for specific endpoints embed ListOptions.
 type ListOptions struct {
 	// Maximum number of items to be returned.
 	// Generally, the default is 25 and max is 100.
@@ -681,6 +927,13 @@ type ListOptions struct {
 	// as the anchor point of the list. Only items
 	// appearing before it will be returned.
 	Before string `url:"before,omitempty"`
+
+	// The number of items already seen in the listing. Reddit only uses it to number the items.
+	Count int `url:"count,omitempty"`
+
+	// If "all", items that would otherwise be hidden by your preferences (e.g. posts you have
+	// hidden or already voted on) are included as well.
+	Show string `url:"show,omitempty"`
 }
 
 // ListSubredditOptions defines possible options used when searching for subreddits.
@@ -711,6 +964,29 @@ type ListUserOverviewOptions struct {
 	Sort string `url:"sort,omitempty"`
 	// One of: hour, day, week, month, year, all.
 	Time string `url:"t,omitempty"`
+	// Only include posts or comments. One of: links, comments.
+	// It applies to the listings that return both, i.e. Overview, Saved and their Things
+	// variants. Setting it on the others has no effect.
+	Type string `url:"type,omitempty"`
+}
+
+// ListRelationshipOptions defines possible options used when getting a subreddit's moderators
+// (SubredditService.ListModerators) or your friends (AccountService.ListFriends). The other
+// relationship listings, e.g. SubredditService.Banned or Contributors, take ListOptions; use
+// Relationship.UserStatus to leave out deleted accounts from their results.
+type ListRelationshipOptions struct {
+	ListOptions
+	// If true, relationships belonging to deleted accounts are left out of the results.
+	// This is applied client-side, so a page may contain fewer items than the limit.
+	ExcludeDeleted bool `url:"-"`
+}
+
+// ListUserFlairOptions defines possible options used when getting the flairs of a subreddit's users.
+// The limit can be as high as 1000.
+type ListUserFlairOptions struct {
+	ListOptions
+	// Only get the flair of this user.
+	Username string `url:"name,omitempty"`
 }
 
 // ListDuplicatePostOptions defines possible options used when getting duplicates of a post, i.e.
@@ -726,6 +1002,15 @@ type ListDuplicatePostOptions struct {
 	CrosspostsOnly bool `url:"crossposts_only,omitempty"`
 }
 
+// ListModQueueOptions defines possible options used when getting the posts and comments
+// in one of a subreddit's moderation queues with ModerationService.ListQueue.
+type ListModQueueOptions struct {
+	ListOptions
+	// If empty, both posts and comments are returned.
+	// One of: links, comments.
+	Only string `url:"only,omitempty"`
+}
+
 // ListModActionOptions defines possible options used when getting moderation actions in a subreddit.
 type ListModActionOptions struct {
 	// The max for the limit parameter here is 500.
@@ -746,12 +1031,32 @@ type ListModActionOptions struct {
 	Moderator string `url:"mod,omitempty"`
 }
 
+// ListModmailOptions defines possible options used when getting modmail conversations.
+// Modmail is paginated like other listings, through the After of the previous page's
+// *Response, but it's the ID of a conversation rather than a full ID. Of the ListOptions,
+// only Limit, whose max is 100, and After are used.
+type ListModmailOptions struct {
+	ListOptions
+	// If empty, conversations from all the subreddits the client moderates are returned.
+	Subreddits []string `url:"entity,omitempty,comma"`
+	// One of: recent, mod, reply, unread.
+	Sort string `url:"sort,omitempty"`
+	// One of: all, new, inprogress, archived, mod, notifications, appeals, join_requests, highlighted.
+	State string `url:"state,omitempty"`
+}
+
 func addOptions(s string, opt interface{}) (string, error) {
 	v := reflect.ValueOf(opt)
 	if v.Kind() == reflect.Ptr && v.IsNil() {
 		return s, nil
 	}
 
+	if v, ok := opt.(validator); ok {
+		if err := v.validate(); err != nil {
+			return s, err
+		}
+	}
+
 	origURL, err := url.Parse(s)
 	if err != nil {
 		return s, err
@@ -9,10 +9,13 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 var ctx = context.Background()
@@ -35,7 +38,8 @@ func setup(t testing.TB) (*Client, *http.ServeMux) {
 	})
 	client, _ := NewClient(
 		Credentials{"client_id", "client_secret", "", ""},
-		//WithAccessToken("access_token_value"),
+		WithBaseURL(server.URL),
+		WithTokenURL(server.URL+"/api/v1/access_token"),
 	)
 	client.InitializeUserAgent("user_agent_value")
 	client.InitializeAccessToken("access_token_value")
@@ -58,10 +62,8 @@ func setupAsync(t testing.TB) (*Client, *http.ServeMux) {
 		w.Header().Add(headerContentType, mediaTypeJSON)
 		fmt.Fprint(w, response)
 	})
-	fmt.Println("server.URL:", server.URL)
 	client, _ := NewClientAsync(
-		WithBaseURL("proxy_base_url"),
-		//WithBearerAuth("proxy_api_key"),
+		WithBaseURL(server.URL),
 	)
 
 	client.InitializeAccessToken("access_token_value")
@@ -200,6 +202,367 @@ func TestClient_OnRequestComplemented(t *testing.T) {
 	require.Equal(t, 6, i)
 }
 
+func TestClient_Do_RequestCoalescing(t *testing.T) {
+	client, mux := setup(t)
+	err := WithRequestCoalescing()(client)
+	require.NoError(t, err)
+
+	var counter int32
+	release := make(chan struct{})
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&counter, 1)
+		if r.Method == http.MethodGet {
+			<-release
+		}
+		fmt.Fprint(w, `{"value": 1}`)
+	})
+
+	const callers = 5
+	var wg sync.WaitGroup
+	results := make([]map[string]int, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+			require.NoError(t, err)
+			_, err = client.Do(ctx, req, &results[i])
+			require.NoError(t, err)
+		}(i)
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&counter))
+	for _, result := range results {
+		require.Equal(t, map[string]int{"value": 1}, result)
+	}
+
+	// Requests that are not identical are not coalesced.
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test?limit=1", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&counter))
+
+	// Neither are requests that aren't GETs.
+	req, err = client.NewRequest(http.MethodPost, "api/v1/test", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(&counter))
+}
+
+func TestClient_Do_RequestCoalescing_Cancel(t *testing.T) {
+	client, mux := setup(t)
+	err := WithRequestCoalescing()(client)
+	require.NoError(t, err)
+
+	var counter int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&counter, 1) == 1 {
+			close(started)
+		}
+		<-release
+		fmt.Fprint(w, `{"value": 1}`)
+	})
+
+	// The caller that starts the request gives up on it.
+	leaderCtx, cancel := context.WithCancel(ctx)
+	leaderErr := make(chan error, 1)
+	go func() {
+		req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+		_, err = client.Do(leaderCtx, req, nil)
+		leaderErr <- err
+	}()
+	<-started
+
+	followerResult := make(chan map[string]int, 1)
+	go func() {
+		req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+		var result map[string]int
+		_, err = client.Do(ctx, req, &result)
+		require.NoError(t, err)
+		followerResult <- result
+	}()
+	time.Sleep(time.Millisecond * 100)
+
+	cancel()
+	require.True(t, errors.Is(<-leaderErr, context.Canceled))
+
+	// The others still get the response.
+	close(release)
+	require.Equal(t, map[string]int{"value": 1}, <-followerResult)
+	require.Equal(t, int32(1), atomic.LoadInt32(&counter))
+}
+
+func TestClient_Do_Cache(t *testing.T) {
+	client, mux := setup(t)
+	err := WithCache(10, time.Minute)(client)
+	require.NoError(t, err)
+
+	var aboutCounter, hotCounter int
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		aboutCounter++
+		fmt.Fprintf(w, `{"value": %d}`, aboutCounter)
+	})
+	mux.HandleFunc("/r/test/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		hotCounter++
+		fmt.Fprintf(w, `{"value": %d}`, hotCounter)
+	})
+
+	get := func(ctx context.Context, path string) map[string]int {
+		req, err := client.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		var result map[string]int
+		_, err = client.Do(ctx, req, &result)
+		require.NoError(t, err)
+		return result
+	}
+
+	require.Equal(t, map[string]int{"value": 1}, get(ctx, "r/test/about"))
+	require.Equal(t, map[string]int{"value": 1}, get(ctx, "r/test/about"))
+	require.Equal(t, 1, aboutCounter)
+
+	require.Equal(t, map[string]int{"value": 2}, get(WithoutCache(ctx), "r/test/about"))
+	require.Equal(t, map[string]int{"value": 2}, get(ctx, "r/test/about"))
+	require.Equal(t, 2, aboutCounter)
+
+	// Listings aren't cached.
+	require.Equal(t, map[string]int{"value": 1}, get(ctx, "r/test/hot"))
+	require.Equal(t, map[string]int{"value": 2}, get(ctx, "r/test/hot"))
+}
+
+func TestClient_Do_Cache_RateLimit(t *testing.T) {
+	client, mux := setup(t)
+	err := WithCache(10, time.Minute)(client)
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		w.Header().Set(headerRateLimitRemaining, "0")
+		w.Header().Set(headerRateLimitUsed, "600")
+		w.Header().Set(headerRateLimitReset, "120")
+		fmt.Fprint(w, `{"value": 1}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "r/test/about", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.IsType(t, &RateLimitError{}, err)
+
+	// The rate limit window passes.
+	client.rateMu.Lock()
+	client.rate = Rate{Remaining: 599, Used: 1, Reset: time.Now().Add(time.Minute)}
+	client.rateMu.Unlock()
+
+	// The cached response neither fails with its stale limit nor brings it back.
+	for i := 0; i < 2; i++ {
+		req, err = client.NewRequest(http.MethodGet, "r/test/about", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(ctx, req, nil)
+		require.NoError(t, err)
+		require.Empty(t, resp.Header.Get(headerRateLimitRemaining))
+	}
+	require.Equal(t, 1, counter)
+
+	client.rateMu.Lock()
+	require.Equal(t, 599, client.rate.Remaining)
+	client.rateMu.Unlock()
+}
+
+func TestClient_Do_ConditionalRequests(t *testing.T) {
+	client, mux := setup(t)
+	err := WithConditionalRequests(10)(client)
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0:
+			require.Empty(t, r.Header.Get(headerIfNoneMatch))
+			w.Header().Set(headerETag, `"v1"`)
+			w.Header().Set(headerRateLimitUsed, "1")
+			fmt.Fprint(w, `{"value": 1}`)
+		case 1:
+			require.Equal(t, `"v1"`, r.Header.Get(headerIfNoneMatch))
+			w.Header().Set(headerETag, `"v1"`)
+			w.Header().Set(headerRateLimitUsed, "2")
+			w.WriteHeader(http.StatusNotModified)
+		case 2:
+			require.Equal(t, `"v1"`, r.Header.Get(headerIfNoneMatch))
+			w.Header().Set(headerETag, `"v2"`)
+			fmt.Fprint(w, `{"value": 2}`)
+		}
+	})
+
+	var result map[string]int
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(ctx, req, &result)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 1, resp.Rate.Used)
+	require.Equal(t, map[string]int{"value": 1}, result)
+
+	result = nil
+	req, err = client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	resp, err = client.Do(ctx, req, &result)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 2, resp.Rate.Used)
+	require.Equal(t, map[string]int{"value": 1}, result)
+	require.Empty(t, req.Header.Get(headerIfNoneMatch))
+
+	result = nil
+	req, err = client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, &result)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"value": 2}, result)
+	require.Equal(t, 3, counter)
+}
+
+func TestClient_Do_CredentialsInvalid(t *testing.T) {
+	client, mux := setup(t)
+	err := WithMaxAuthFailures(2)(client)
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		defer func() { counter++ }()
+
+		switch counter {
+		case 1:
+			// a success resets the count
+		case 3:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"reason": "USER_REQUIRED", "message": "Forbidden", "error": 403}`)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.Error(t, err)
+	require.NotEqual(t, ErrCredentialsInvalid, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.Error(t, err)
+	require.NotEqual(t, ErrCredentialsInvalid, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	require.Equal(t, 4, counter)
+
+	// no more requests are made
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	require.Equal(t, 4, counter)
+}
+
+func TestClient_Do_CredentialsInvalid_Reset(t *testing.T) {
+	client, mux := setup(t)
+
+	var counter int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	// by default, the client never gives up
+	for i := 0; i < 5; i++ {
+		_, err = client.Do(ctx, req, nil)
+		require.Error(t, err)
+		require.NotEqual(t, ErrCredentialsInvalid, err)
+	}
+	require.Equal(t, 5, counter)
+
+	err = WithMaxAuthFailures(1)(client)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	require.Equal(t, 6, counter)
+
+	client.ResetAuthFailures()
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	require.Equal(t, 7, counter)
+
+	// a new token is tried too
+	client.InitializeAccessToken("new_access_token")
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	require.Equal(t, 8, counter)
+}
+
+func TestClient_Do_CredentialsInvalid_TokenEndpoint(t *testing.T) {
+	client, mux := setup(t)
+	err := WithMaxAuthFailures(2)(client)
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/api/v1/rejected_token", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		w.Header().Set(headerContentType, mediaTypeJSON)
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message": "Unauthorized", "error": 401}`)
+	})
+
+	config := &oauth2.Config{
+		ClientID:     "client_id",
+		ClientSecret: "client_secret",
+		Endpoint: oauth2.Endpoint{
+			TokenURL:  client.BaseURL.String() + "/api/v1/rejected_token",
+			AuthStyle: oauth2.AuthStyleInHeader,
+		},
+	}
+	client.client.Transport = &oauth2.Transport{
+		Source: config.TokenSource(ctx, &oauth2.Token{RefreshToken: "refresh_token"}),
+	}
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.Error(t, err)
+	require.NotEqual(t, ErrCredentialsInvalid, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	require.Equal(t, 2, counter)
+
+	// the token endpoint isn't asked again
+	_, err = client.Do(ctx, req, nil)
+	require.Equal(t, ErrCredentialsInvalid, err)
+	require.Equal(t, 2, counter)
+}
+
 func TestClient_JSONErrorResponse(t *testing.T) {
 	client, mux := setup(t)
 
@@ -296,3 +659,21 @@ func TestClient_Do_RateLimitError(t *testing.T) {
 	require.Equal(t, 600, resp.Rate.Used)
 	require.Equal(t, time.Now().Truncate(time.Second).Add(time.Minute*4), resp.Rate.Reset)
 }
+
+func TestClient_Do_ResponseMetadata(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set(headerRequestID, "abc123")
+		time.Sleep(time.Millisecond * 10)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, "abc123", resp.RequestID)
+	require.GreaterOrEqual(t, int64(resp.Duration), int64(time.Millisecond*10))
+}
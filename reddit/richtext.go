@@ -0,0 +1,181 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RichText is a document in the richtext format new Reddit uses for the bodies of posts and
+// comments. Use PostService.MarkdownToRichText and PostService.RichTextToMarkdown to convert
+// between it and markdown.
+type RichText struct {
+	Document []*RichTextNode `json:"document"`
+}
+
+// RichTextNode is an element of a richtext document, e.g. a paragraph, or the text in one.
+type RichTextNode struct {
+	// One of: par, text, link, h (heading), list, li (list item), blockquote, code, raw, hr, table, spoilertext, emoji.
+	Element string `json:"e"`
+
+	Text string `json:"t,omitempty"`
+	// The styles of ranges of the text, as [style, start, length] triples.
+	// The style is a bit mask, e.g. 1 for bold, 2 for italic and 8 for strikethrough.
+	Format [][]int `json:"f,omitempty"`
+	URL    string  `json:"u,omitempty"`
+
+	// The level of a heading.
+	Level int `json:"l,omitempty"`
+	// Whether a list is numbered.
+	Ordered bool `json:"o,omitempty"`
+
+	Children []*RichTextNode `json:"c,omitempty"`
+}
+
+// NewRichText returns a richtext document with a paragraph of plain text for each of the paragraphs.
+func NewRichText(paragraphs ...string) *RichText {
+	rt := new(RichText)
+	for _, paragraph := range paragraphs {
+		rt.Document = append(rt.Document, &RichTextNode{
+			Element:  "par",
+			Children: []*RichTextNode{{Element: "text", Text: paragraph}},
+		})
+	}
+	return rt
+}
+
+// RichTextFlairString returns the text of a richtext flair (Flair.RichText or FlairTemplate.RichText),
+// with its emojis written as :name:, e.g. "Verified :snoo:".
+func RichTextFlairString(richtext []map[string]string) string {
+	var b strings.Builder
+	for _, part := range richtext {
+		switch part["e"] {
+		case "text":
+			b.WriteString(part["t"])
+		case "emoji":
+			b.WriteString(part["a"])
+		}
+	}
+	return b.String()
+}
+
+// NewRichTextFlair returns the richtext of a flair with the text, in which the emojis are written
+// as :name:, e.g. "Verified :snoo:". Names that aren't the name of one of the emojis stay text.
+func NewRichTextFlair(text string, emojis ...*Emoji) []map[string]string {
+	urls := make(map[string]string, len(emojis))
+	for _, emoji := range emojis {
+		urls[emoji.Name] = emoji.URL
+	}
+
+	var richtext []map[string]string
+	addText := func(t string) {
+		if t == "" {
+			return
+		}
+		if last := len(richtext) - 1; last >= 0 && richtext[last]["e"] == "text" {
+			richtext[last]["t"] += t
+			return
+		}
+		richtext = append(richtext, map[string]string{"e": "text", "t": t})
+	}
+
+	for {
+		start := strings.IndexByte(text, ':')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(text[start+1:], ':')
+		if end < 0 {
+			break
+		}
+		end += start + 1
+
+		name := text[start+1 : end]
+		u, ok := urls[name]
+		if !ok {
+			// the closing colon might open the next emoji
+			addText(text[:end])
+			text = text[end:]
+			continue
+		}
+
+		addText(text[:start])
+		richtext = append(richtext, map[string]string{"e": "emoji", "a": ":" + name + ":", "u": u})
+		text = text[end+1:]
+	}
+	addText(text)
+
+	return richtext
+}
+
+type convertRichTextRoot struct {
+	Output     json.RawMessage `json:"output"`
+	OutputMode string          `json:"output_mode"`
+}
+
+// MarkdownToRichText converts the markdown to a richtext document.
+func (s *PostService) MarkdownToRichText(ctx context.Context, markdown string) (*RichText, *Response, error) {
+	form := url.Values{}
+	form.Set("output_mode", "rtjson")
+	form.Set("markdown_text", markdown)
+
+	root, resp, err := s.convertRichText(ctx, form)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	rt := new(RichText)
+	if err := json.Unmarshal(root.Output, rt); err != nil {
+		return nil, resp, err
+	}
+
+	return rt, resp, nil
+}
+
+// RichTextToMarkdown converts the richtext document to markdown.
+func (s *PostService) RichTextToMarkdown(ctx context.Context, rt *RichText) (string, *Response, error) {
+	if rt == nil {
+		return "", nil, errors.New("*RichText: cannot be nil")
+	}
+
+	richtextJSON, err := json.Marshal(rt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	form := url.Values{}
+	form.Set("output_mode", "markdown")
+	form.Set("richtext_json", string(richtextJSON))
+
+	root, resp, err := s.convertRichText(ctx, form)
+	if err != nil {
+		return "", resp, err
+	}
+
+	var markdown string
+	if err := json.Unmarshal(root.Output, &markdown); err != nil {
+		return "", resp, err
+	}
+
+	return markdown, resp, nil
+}
+
+func (s *PostService) convertRichText(ctx context.Context, form url.Values) (*convertRichTextRoot, *Response, error) {
+	path := "api/convert_rte_body_format"
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(convertRichTextRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
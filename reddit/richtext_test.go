@@ -0,0 +1,115 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var expectedRichText = &RichText{
+	Document: []*RichTextNode{
+		{
+			Element: "par",
+			Children: []*RichTextNode{
+				{Element: "text", Text: "hello world", Format: [][]int{{1, 0, 5}}},
+			},
+		},
+		{
+			Element: "par",
+			Children: []*RichTextNode{
+				{Element: "link", Text: "a link", URL: "https://example.com"},
+			},
+		},
+	},
+}
+
+func TestNewRichText(t *testing.T) {
+	require.Equal(t, &RichText{
+		Document: []*RichTextNode{
+			{Element: "par", Children: []*RichTextNode{{Element: "text", Text: "hello"}}},
+			{Element: "par", Children: []*RichTextNode{{Element: "text", Text: "world"}}},
+		},
+	}, NewRichText("hello", "world"))
+}
+
+func TestRichTextFlairString(t *testing.T) {
+	require.Equal(t, "Verified :snoo: user", RichTextFlairString([]map[string]string{
+		{"e": "text", "t": "Verified "},
+		{"e": "emoji", "a": ":snoo:", "u": "https://emoji.redditmedia.com/snoo.png"},
+		{"e": "text", "t": " user"},
+	}))
+	require.Equal(t, "", RichTextFlairString(nil))
+}
+
+func TestNewRichTextFlair(t *testing.T) {
+	snoo := &Emoji{Name: "snoo", URL: "https://emoji.redditmedia.com/snoo.png"}
+
+	require.Equal(t, []map[string]string{
+		{"e": "text", "t": "Verified "},
+		{"e": "emoji", "a": ":snoo:", "u": "https://emoji.redditmedia.com/snoo.png"},
+		{"e": "text", "t": " at 10:30"},
+	}, NewRichTextFlair("Verified :snoo: at 10:30", snoo))
+
+	require.Equal(t, []map[string]string{
+		{"e": "text", "t": "a:b:"},
+		{"e": "emoji", "a": ":snoo:", "u": "https://emoji.redditmedia.com/snoo.png"},
+	}, NewRichTextFlair("a:b::snoo:", snoo))
+
+	require.Nil(t, NewRichTextFlair(""))
+}
+
+func TestPostService_MarkdownToRichText(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/convert-rte-rtjson.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/convert_rte_body_format", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("output_mode", "rtjson")
+		form.Set("markdown_text", "**hello** world\n\n[a link](https://example.com)")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	rt, _, err := client.Post.MarkdownToRichText(ctx, "**hello** world\n\n[a link](https://example.com)")
+	require.NoError(t, err)
+	require.Equal(t, expectedRichText, rt)
+}
+
+func TestPostService_RichTextToMarkdown(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/convert-rte-markdown.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/convert_rte_body_format", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("output_mode", "markdown")
+		form.Set("richtext_json", `{"document":[{"e":"par","c":[{"e":"text","t":"hello world","f":[[1,0,5]]}]},{"e":"par","c":[{"e":"link","t":"a link","u":"https://example.com"}]}]}`)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.RichTextToMarkdown(ctx, nil)
+	require.EqualError(t, err, "*RichText: cannot be nil")
+
+	markdown, _, err := client.Post.RichTextToMarkdown(ctx, expectedRichText)
+	require.NoError(t, err)
+	require.Equal(t, "**hello** world\n\n[a link](https://example.com)", markdown)
+}
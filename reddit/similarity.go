@@ -0,0 +1,140 @@
+package reddit
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// shingleSize is the number of consecutive words that make up a shingle.
+const shingleSize = 3
+
+// Simhash returns a 64-bit fingerprint of the text, built from its shingles (runs of
+// consecutive words), ignoring case and punctuation. Texts that share most of their
+// shingles have fingerprints that differ in few bits; see HammingDistance.
+// Text without any words has a fingerprint of 0.
+func Simhash(text string) uint64 {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) == 0 {
+		return 0
+	}
+
+	n := len(words) - shingleSize + 1
+	if n < 1 {
+		n = 1
+	}
+
+	var weights [64]int
+	for i := 0; i < n; i++ {
+		end := i + shingleSize
+		if end > len(words) {
+			end = len(words)
+		}
+
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:end], " ")))
+		sum := h.Sum64()
+
+		for b := 0; b < 64; b++ {
+			if sum&(1<<uint(b)) != 0 {
+				weights[b]++
+			} else {
+				weights[b]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for b, w := range weights {
+		if w > 0 {
+			fingerprint |= 1 << uint(b)
+		}
+	}
+	return fingerprint
+}
+
+// HammingDistance returns the number of bits that differ between 2 fingerprints.
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// DuplicateDetector remembers the fingerprints of texts seen within a time window, to flag
+// texts that are near-duplicates of ones seen before, e.g. copy-pasted spam.
+// It is safe for concurrent use.
+type DuplicateDetector struct {
+	window      time.Duration
+	maxDistance int
+
+	mu      sync.Mutex
+	entries []fingerprintEntry
+}
+
+type fingerprintEntry struct {
+	id          string
+	fingerprint uint64
+	created     time.Time
+}
+
+// NewDuplicateDetector returns a DuplicateDetector that compares each text with the texts
+// created up to window before it. Texts whose fingerprints differ in at most maxDistance
+// bits are near-duplicates; 3 is a reasonable starting point.
+func NewDuplicateDetector(window time.Duration, maxDistance int) *DuplicateDetector {
+	return &DuplicateDetector{window: window, maxDistance: maxDistance}
+}
+
+// Check records the text, and returns the ID of an earlier text within the window that it
+// is a near-duplicate of, if there is one. Texts without any words are never duplicates.
+func (d *DuplicateDetector) Check(id string, text string, created time.Time) (string, bool) {
+	fingerprint := Simhash(text)
+	if fingerprint == 0 {
+		return "", false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	oldest := created.Add(-d.window)
+	kept := d.entries[:0]
+	for _, e := range d.entries {
+		if !e.created.Before(oldest) {
+			kept = append(kept, e)
+		}
+	}
+	d.entries = kept
+
+	var duplicateOf string
+	for _, e := range d.entries {
+		if e.id != id && HammingDistance(e.fingerprint, fingerprint) <= d.maxDistance {
+			duplicateOf = e.id
+			break
+		}
+	}
+
+	d.entries = append(d.entries, fingerprintEntry{id: id, fingerprint: fingerprint, created: created})
+	return duplicateOf, duplicateOf != ""
+}
+
+// IsDuplicatePost checks the post's body. It can be used with StreamFilter to only stream
+// near-duplicate posts.
+func (d *DuplicateDetector) IsDuplicatePost(post *Post) bool {
+	_, ok := d.Check(post.FullID, post.Body, createdOrNow(post.Created))
+	return ok
+}
+
+// IsDuplicateComment checks the comment's body.
+func (d *DuplicateDetector) IsDuplicateComment(comment *Comment) bool {
+	_, ok := d.Check(comment.FullID, comment.Body, createdOrNow(comment.Created))
+	return ok
+}
+
+func createdOrNow(t *Timestamp) time.Time {
+	if t == nil {
+		return time.Now()
+	}
+	return t.Time
+}
@@ -0,0 +1,51 @@
+package reddit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimhash(t *testing.T) {
+	text := "Check out my amazing new crypto project, it will make you rich in no time at all. Sign up today using my referral link!"
+	edited := "Check out my AMAZING new crypto project - it will make you rich in no time at all! Sign up today using my referral link"
+	similar := "Check out my amazing new crypto project, it will make you rich in no time at all. Sign up now using my referral link!"
+	other := "Does anyone know a good recipe for sourdough bread? Mine always comes out flat and dense, no matter how long I proof it."
+
+	require.Equal(t, uint64(0), Simhash(""))
+	require.Equal(t, uint64(0), Simhash("?!"))
+	require.Equal(t, Simhash(text), Simhash(edited))
+	require.NotEqual(t, uint64(0), Simhash("hi"))
+
+	require.LessOrEqual(t, HammingDistance(Simhash(text), Simhash(similar)), 12)
+	require.Greater(t, HammingDistance(Simhash(text), Simhash(other)), 12)
+}
+
+func TestDuplicateDetector(t *testing.T) {
+	detector := NewDuplicateDetector(time.Hour, 3)
+	now := time.Now()
+
+	text := "Check out my amazing new crypto project, it will make you rich in no time at all."
+
+	_, ok := detector.Check("t3_1", text, now)
+	require.False(t, ok)
+
+	id, ok := detector.Check("t3_2", "CHECK OUT my amazing new crypto project... it will make you rich in no time at all", now.Add(time.Minute))
+	require.True(t, ok)
+	require.Equal(t, "t3_1", id)
+
+	_, ok = detector.Check("t3_3", "Something else entirely, about gardening and tomatoes.", now.Add(time.Minute))
+	require.False(t, ok)
+
+	_, ok = detector.Check("t3_4", "", now.Add(time.Minute))
+	require.False(t, ok)
+
+	// t3_1 and t3_2 have fallen out of the window by now.
+	_, ok = detector.Check("t3_5", text, now.Add(time.Hour*2))
+	require.False(t, ok)
+
+	require.True(t, detector.IsDuplicatePost(&Post{FullID: "t3_6", Body: text}))
+	require.True(t, detector.IsDuplicateComment(&Comment{FullID: "t1_1", Body: text}))
+	require.False(t, detector.IsDuplicateComment(&Comment{FullID: "t1_2", Body: "Thanks, this was helpful."}))
+}
@@ -0,0 +1,104 @@
+package reddit
+
+import (
+	"errors"
+	"strings"
+)
+
+// Sort is an order in which a listing's items can be sorted.
+// Listings support different sorts; see the Sort field of their options.
+type Sort string
+
+// Sorts supported by listings.
+const (
+	SortHot           Sort = "hot"
+	SortNew           Sort = "new"
+	SortTop           Sort = "top"
+	SortControversial Sort = "controversial"
+	SortRising        Sort = "rising"
+	SortRelevance     Sort = "relevance"
+	SortComments      Sort = "comments"
+	SortActivity      Sort = "activity"
+)
+
+var sorts = []Sort{SortHot, SortNew, SortTop, SortControversial, SortRising, SortRelevance, SortComments, SortActivity}
+
+// Validate returns an error if s isn't one of the sorts supported by listings.
+func (s Sort) Validate() error {
+	return s.validate(sorts...)
+}
+
+// validate returns an error if s isn't one of the allowed sorts. An empty sort is allowed,
+// since Reddit then uses the listing's default.
+func (s Sort) validate(allowed ...Sort) error {
+	if s == "" {
+		return nil
+	}
+	for _, sort := range allowed {
+		if s == sort {
+			return nil
+		}
+	}
+
+	names := make([]string, len(allowed))
+	for i, sort := range allowed {
+		names[i] = string(sort)
+	}
+	return errors.New("sort: must be one of " + strings.Join(names, ", "))
+}
+
+// Timespan is the period of time a listing's items are taken from, for sorts that rank them
+// over one, i.e. top and controversial.
+type Timespan string
+
+// Timespans supported by listings.
+const (
+	TimespanHour  Timespan = "hour"
+	TimespanDay   Timespan = "day"
+	TimespanWeek  Timespan = "week"
+	TimespanMonth Timespan = "month"
+	TimespanYear  Timespan = "year"
+	TimespanAll   Timespan = "all"
+)
+
+// Validate returns an error if t isn't one of the supported timespans.
+func (t Timespan) Validate() error {
+	switch t {
+	case "", TimespanHour, TimespanDay, TimespanWeek, TimespanMonth, TimespanYear, TimespanAll:
+		return nil
+	}
+	return errors.New("time: must be one of hour, day, week, month, year, all")
+}
+
+// validator is implemented by options that can be checked before they're sent.
+type validator interface {
+	validate() error
+}
+
+func (opts *ListSubredditOptions) validate() error {
+	return Sort(opts.Sort).validate(SortRelevance, SortActivity)
+}
+
+func (opts *ListPostOptions) validate() error {
+	return Timespan(opts.Time).Validate()
+}
+
+func (opts *ListPostSearchOptions) validate() error {
+	if err := opts.ListPostOptions.validate(); err != nil {
+		return err
+	}
+	return Sort(opts.Sort).validate(SortRelevance, SortHot, SortTop, SortNew, SortComments)
+}
+
+func (opts *ListUserOverviewOptions) validate() error {
+	if err := Sort(opts.Sort).validate(SortHot, SortNew, SortTop, SortControversial); err != nil {
+		return err
+	}
+	if err := Timespan(opts.Time).Validate(); err != nil {
+		return err
+	}
+	if opts.Time != "" && (opts.Sort == string(SortHot) || opts.Sort == string(SortNew)) {
+		return errors.New("time: only applies to the top and controversial sorts")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package reddit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSort_Validate(t *testing.T) {
+	require.NoError(t, SortTop.Validate())
+	require.NoError(t, Sort("").Validate())
+	require.EqualError(t, Sort("best").Validate(), "sort: must be one of hot, new, top, controversial, rising, relevance, comments, activity")
+}
+
+func TestTimespan_Validate(t *testing.T) {
+	require.NoError(t, TimespanWeek.Validate())
+	require.NoError(t, Timespan("").Validate())
+	require.EqualError(t, Timespan("decade").Validate(), "time: must be one of hour, day, week, month, year, all")
+}
+
+func TestListOptions_Validate(t *testing.T) {
+	client, _ := setup(t)
+
+	_, _, err := client.Subreddit.TopPosts(ctx, "test", &ListPostOptions{Time: "decade"})
+	require.EqualError(t, err, "time: must be one of hour, day, week, month, year, all")
+
+	_, _, err = client.Subreddit.SearchPosts(ctx, "test", "", "test", &ListPostSearchOptions{Sort: "rising"})
+	require.EqualError(t, err, "sort: must be one of relevance, hot, top, new, comments")
+
+	_, _, err = client.Subreddit.Search(ctx, "test", &ListSubredditOptions{Sort: "new"})
+	require.EqualError(t, err, "sort: must be one of relevance, activity")
+
+	_, _, _, err = client.User.Overview(ctx, &ListUserOverviewOptions{Sort: string(SortNew), Time: string(TimespanWeek)})
+	require.EqualError(t, err, "time: only applies to the top and controversial sorts")
+}
@@ -2,6 +2,8 @@ package reddit
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"sync"
 	"time"
 )
@@ -45,6 +47,31 @@ func (s *StreamService) Posts(subreddit string, opts ...StreamOpt) (<-chan *Post
 	// would just return empty listings; easier to just keep track of all post ids encountered
 	ids := set{}
 
+	emit := func(post *Post) {
+		for _, filter := range streamConfig.Filters {
+			if !filter(post) {
+				return
+			}
+		}
+		postsCh <- post
+	}
+
+	// posts held back by the reorder window, if there is one
+	var pending []*Post
+	send := func(final bool) {
+		watermark := time.Now().Add(-streamConfig.ReorderWindow)
+		sortPostsByCreated(pending)
+
+		i := 0
+		for ; i < len(pending); i++ {
+			if !final && pending[i].Created != nil && pending[i].Created.After(watermark) {
+				break
+			}
+			emit(pending[i])
+		}
+		pending = pending[i:]
+	}
+
 	go func() {
 		defer stop()
 
@@ -57,6 +84,10 @@ func (s *StreamService) Posts(subreddit string, opts ...StreamOpt) (<-chan *Post
 			posts, err := s.getPosts(subreddit)
 			if err != nil {
 				errsCh <- err
+				// the client won't make any more requests, so there's no point in carrying on
+				if errors.Is(err, ErrCredentialsInvalid) {
+					break
+				}
 				if !infinite && n >= streamConfig.MaxRequests {
 					break
 				}
@@ -78,13 +109,23 @@ func (s *StreamService) Posts(subreddit string, opts ...StreamOpt) (<-chan *Post
 					break
 				}
 
-				postsCh <- post
+				if streamConfig.ReorderWindow > 0 {
+					pending = append(pending, post)
+					continue
+				}
+				emit(post)
+			}
+
+			if streamConfig.ReorderWindow > 0 {
+				send(false)
 			}
 
 			if !infinite && n >= streamConfig.MaxRequests {
 				break
 			}
 		}
+
+		send(true)
 	}()
 
 	return postsCh, errsCh, stop
@@ -95,6 +136,21 @@ func (s *StreamService) getPosts(subreddit string) ([]*Post, error) {
 	return posts, err
 }
 
+// sortPostsByCreated sorts the posts from oldest to newest, breaking ties by full ID
+// so that the order is the same no matter the order the posts were fetched in.
+func sortPostsByCreated(posts []*Post) {
+	sort.SliceStable(posts, func(i, j int) bool {
+		a, b := posts[i].Created, posts[j].Created
+		switch {
+		case a == nil || b == nil:
+			return a == nil && b != nil
+		case !a.Equal(*b):
+			return a.Before(b.Time)
+		}
+		return posts[i].FullID < posts[j].FullID
+	})
+}
+
 type set map[string]struct{}
 
 func (s set) Add(v string) {
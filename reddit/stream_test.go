@@ -308,3 +308,120 @@ loop:
 
 	require.Len(t, expectedPostIDs, i)
 }
+
+func TestStreamService_Posts_ReorderWindow(t *testing.T) {
+	client, mux := setup(t)
+
+	now := time.Now().Unix()
+	post := func(id string, age time.Duration) string {
+		return fmt.Sprintf(`{"kind": "t3", "data": {"name": %q, "created_utc": %d}}`, id, now-int64(age.Seconds()))
+	}
+
+	var counter int
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0:
+			fmt.Fprintf(w, `{"kind": "Listing", "data": {"children": [%s, %s, %s]}}`,
+				post("t3_post3", 0),
+				post("t3_post2", time.Hour*2),
+				post("t3_post1", time.Hour*3),
+			)
+		case 1:
+			fmt.Fprintf(w, `{"kind": "Listing", "data": {"children": [%s, %s, %s]}}`,
+				post("t3_post4", time.Second*10),
+				post("t3_post5", time.Second*20),
+				post("t3_post3", 0),
+			)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	posts, errs, stop := client.Stream.Posts("testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(2), StreamReorderWindow(time.Hour))
+	defer stop()
+
+	// post1 and post2 are older than the window, so they are sent right away.
+	// The rest are held back until the stream ends, and are then sent oldest first.
+	expectedPostIDs := []string{"t3_post1", "t3_post2", "t3_post5", "t3_post4", "t3_post3"}
+	var i int
+
+loop:
+	for i != len(expectedPostIDs) {
+		select {
+		case post, ok := <-posts:
+			if !ok {
+				break loop
+			}
+			require.Equal(t, expectedPostIDs[i], post.FullID)
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+		i++
+	}
+
+	require.Len(t, expectedPostIDs, i)
+}
+
+func TestStreamService_Posts_Filter(t *testing.T) {
+	client, mux := setup(t)
+
+	now := time.Now().Unix()
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprintf(w, `{"kind": "Listing", "data": {"children": [
+			{"kind": "t3", "data": {"name": "t3_post3", "created_utc": %d, "selftext": "Totally different text about gardening"}},
+			{"kind": "t3", "data": {"name": "t3_post2", "created_utc": %d, "selftext": "Buy cheap followers today at my shop, best prices!"}},
+			{"kind": "t3", "data": {"name": "t3_post1", "created_utc": %d, "selftext": "buy cheap followers today at my shop... best prices"}}
+		]}}`, now-10, now-20, now-30)
+	})
+
+	detector := NewDuplicateDetector(time.Hour, 3)
+	posts, errs, stop := client.Stream.Posts("testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(1), StreamReorderWindow(time.Hour), StreamFilter(detector.IsDuplicatePost))
+	defer stop()
+
+	var postIDs []string
+loop:
+	for {
+		select {
+		case post, ok := <-posts:
+			if !ok {
+				break loop
+			}
+			postIDs = append(postIDs, post.FullID)
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, []string{"t3_post2"}, postIDs)
+}
+
+func TestStreamService_Posts_CredentialsInvalid(t *testing.T) {
+	client, mux := setup(t)
+	err := WithMaxAuthFailures(1)(client)
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	posts, errs, stop := client.Stream.Posts("testsubreddit", StreamInterval(time.Millisecond*10))
+	defer stop()
+
+	err = <-errs
+	require.Equal(t, ErrCredentialsInvalid, err)
+
+	// the stream stops by itself
+	_, ok := <-posts
+	require.False(t, ok)
+}
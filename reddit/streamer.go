@@ -8,6 +8,8 @@ type streamConfig struct {
 	Interval       time.Duration
 	DiscardInitial bool
 	MaxRequests    int
+	ReorderWindow  time.Duration
+	Filters        []func(*Post) bool
 }
 
 // StreamOpt is a configuration option to configure a stream.
@@ -38,6 +40,31 @@ func StreamMaxRequests(v int) StreamOpt {
 	}
 }
 
+// StreamReorderWindow holds items back until they are older than the window, so that
+// items created close together are sent in order of creation (oldest first), even when
+// they are fetched out of order, e.g. when streaming multiple subreddits at once.
+// Items that only show up after the window has passed them are still sent, but may be
+// out of order. If the duration is 0 or less, items are sent as soon as they are fetched.
+func StreamReorderWindow(v time.Duration) StreamOpt {
+	return func(c *streamConfig) {
+		if v > 0 {
+			c.ReorderWindow = v
+		}
+	}
+}
+
+// StreamFilter only sends the posts for which f returns true. Filters are applied in the
+// order they are given, after the reorder window if there is one, so f sees posts in the
+// order they are sent. For example, StreamFilter(detector.IsDuplicatePost) only streams
+// posts that are near-duplicates of earlier ones.
+func StreamFilter(f func(*Post) bool) StreamOpt {
+	return func(c *streamConfig) {
+		if f != nil {
+			c.Filters = append(c.Filters, f)
+		}
+	}
+}
+
 // Streamer streams data to the client.
 // type Streamer interface {
 // 	Stream() (<-chan *rootListing, <-chan error, func())
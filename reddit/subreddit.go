@@ -12,7 +12,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -35,6 +37,28 @@ type Relationship struct {
 	User    string     `json:"name,omitempty"`
 	UserID  string     `json:"id,omitempty"`
 	Created *Timestamp `json:"date,omitempty"`
+	// The note you left about a friend. Requires Reddit Premium.
+	Note string `json:"note,omitempty"`
+}
+
+// RelationshipUserStatus is the state of the account on the user side of a relationship.
+type RelationshipUserStatus int
+
+const (
+	// RelationshipUserActive is an account that still exists.
+	RelationshipUserActive RelationshipUserStatus = iota
+	// RelationshipUserDeleted is an account that has been deleted. Reddit keeps the
+	// relationship around, but the user's name is replaced with "[deleted]".
+	RelationshipUserDeleted
+)
+
+// UserStatus reports whether the user of the relationship is still active.
+// It can be used to filter deleted accounts out of any relationship listing.
+func (r *Relationship) UserStatus() RelationshipUserStatus {
+	if r.User == "[deleted]" || r.UserID == "" {
+		return RelationshipUserDeleted
+	}
+	return RelationshipUserActive
 }
 
 // Moderator is a user who moderates a subreddit.
@@ -43,6 +67,12 @@ type Moderator struct {
 	Permissions []string `json:"mod_permissions"`
 }
 
+// ModPermissions returns the moderator's permissions as a ModPermissions.
+// A moderator with the "all" permission has every permission set.
+func (m *Moderator) ModPermissions() *ModPermissions {
+	return newModPermissions(m.Permissions)
+}
+
 // Ban represents a banned relationship.
 type Ban struct {
 	*Relationship
@@ -192,9 +222,15 @@ type SubredditSettings struct {
 	AllowMultipleImagesPerPost *bool `url:"allow_galleries,omitempty" json:"allow_galleries,omitempty"`
 
 	ExcludeSitewideBannedUsersContent *bool `url:"exclude_banned_modqueue,omitempty" json:"exclude_banned_modqueue,omitempty"`
+	// Stop users from messaging the moderators to ask to become approved users.
+	DisableContributorRequests *bool `url:"disable_contributor_requests,omitempty" json:"disable_contributor_requests,omitempty"`
 
 	// An integer from 0 to 3.
 	CrowdControlChalLevel *int `url:"crowd_control_chat_level,omitempty" json:"crowd_control_chat_level,omitempty"`
+	// Collapse comments from people who aren't trusted members of the subreddit yet.
+	CrowdControlMode *bool `url:"crowd_control_mode,omitempty" json:"crowd_control_mode,omitempty"`
+	// An integer from 0 to 3. The higher the level, the more comments get collapsed.
+	CrowdControlLevel *int `url:"crowd_control_level,omitempty" json:"crowd_control_level,omitempty"`
 
 	// Mark all posts in this subreddit as Original Content (OC) on the desktop redesign.
 	AllOriginalContent *bool `url:"all_original_content,omitempty" json:"all_original_content,omitempty"`
@@ -418,10 +454,18 @@ func (s *SubredditService) GetSticky1(ctx context.Context, subreddit string) (*P
 }
 
 // GetSticky2 returns the second stickied post on a subreddit (if it exists).
+// To get both stickied posts in a single request, without their comments, use GetStickies.
 func (s *SubredditService) GetSticky2(ctx context.Context, subreddit string) (*PostAndComments, *Response, error) {
 	return s.getSticky(ctx, subreddit, 2)
 }
 
+// GetStickies returns the subreddit's stickied posts, of which there are up to 2, in a single
+// request. Unlike GetSticky1 and GetSticky2, it doesn't return the posts' comments.
+// It is the same as ListPinned.
+func (s *SubredditService) GetStickies(ctx context.Context, subreddit string) ([]*Post, *Response, error) {
+	return s.ListPinned(ctx, subreddit)
+}
+
 func (s *SubredditService) handleSubscription(ctx context.Context, form url.Values) (*Response, error) {
 	path := "api/subscribe"
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
@@ -497,6 +541,29 @@ func (s *SubredditService) Unfavorite(ctx context.Context, subreddit string) (*R
 	return s.client.Do(ctx, req, nil)
 }
 
+// OptInQuarantine opts in to seeing the quarantined subreddit, so that it, and its posts and
+// comments, can be accessed without getting ErrQuarantined.
+func (s *SubredditService) OptInQuarantine(ctx context.Context, subreddit string) (*Response, error) {
+	return s.quarantine(ctx, "api/quarantine_optin", subreddit)
+}
+
+// OptOutQuarantine opts out of seeing the quarantined subreddit.
+func (s *SubredditService) OptOutQuarantine(ctx context.Context, subreddit string) (*Response, error) {
+	return s.quarantine(ctx, "api/quarantine_optout", subreddit)
+}
+
+func (s *SubredditService) quarantine(ctx context.Context, path, subreddit string) (*Response, error) {
+	form := url.Values{}
+	form.Set("sr_name", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // Search for subreddits.
 func (s *SubredditService) Search(ctx context.Context, query string, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
 	path := fmt.Sprintf("subreddits/search?q=%s", query)
@@ -525,21 +592,114 @@ func (s *SubredditService) SearchNames(ctx context.Context, query string) ([]str
 	return root.Names, resp, nil
 }
 
+// Trending returns the names of the subreddits that are trending site-wide today.
+func (s *SubredditService) Trending(ctx context.Context) ([]string, *Response, error) {
+	path := "api/trending_subreddits"
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Names []string `json:"subreddit_names"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Names, resp, nil
+}
+
+// Recommended returns the names of subreddits similar to the ones provided.
+// Subreddits in omit are left out of the results, e.g. ones the user is already subscribed to.
+func (s *SubredditService) Recommended(ctx context.Context, subreddits []string, omit []string) ([]string, *Response, error) {
+	if len(subreddits) == 0 {
+		return nil, nil, errors.New("must provide at least 1 subreddit")
+	}
+
+	params := struct {
+		Omit []string `url:"omit,omitempty,comma"`
+	}{omit}
+
+	path := fmt.Sprintf("api/recommend/sr/%s", strings.Join(subreddits, ","))
+	path, err := addOptions(path, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root []struct {
+		Name string `json:"sr_name"`
+	}
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	names := make([]string, 0, len(root))
+	for _, sr := range root {
+		names = append(names, sr.Name)
+	}
+
+	return names, resp, nil
+}
+
 // SearchPosts searches for posts in the specified subreddit.
 // To search through multiple, separate the names with a plus (+), e.g. "golang+test".
 // If no subreddit is provided, the search is run against r/all.
 func (s *SubredditService) SearchPosts(ctx context.Context, query, searchType, subreddit string, opts *ListPostSearchOptions) ([]*Post, *Response, error) {
-	if subreddit == "" {
-		subreddit = "all"
-	}
 	if searchType == "" {
 		searchType = "link"
 	}
 
+	t := new(thing)
+	resp, err := s.search(ctx, query, searchType, subreddit, opts, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	l, _ := t.Listing()
+	return l.Posts(), resp, nil
+}
+
+// SearchThings searches for things of the types in searchType in the specified subreddit, the
+// same way as SearchPosts. The types are comma-separated, out of link (posts), sr (subreddits)
+// and user, e.g. "link,sr". Reddit lists the things of each type separately, so when searching
+// for several types, the things are returned grouped by type, and the response has no After.
+func (s *SubredditService) SearchThings(ctx context.Context, query, searchType, subreddit string, opts *ListPostSearchOptions) ([]Thing, *Response, error) {
+	if searchType == "" {
+		return nil, nil, errors.New("searchType: cannot be empty")
+	}
+
+	root := new(searchResults)
+	resp, err := s.search(ctx, query, searchType, subreddit, opts, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var things []Thing
+	for _, l := range *root {
+		things = append(things, l.Things()...)
+	}
+
+	return things, resp, nil
+}
+
+func (s *SubredditService) search(ctx context.Context, query, searchType, subreddit string, opts *ListPostSearchOptions, v interface{}) (*Response, error) {
+	if subreddit == "" {
+		subreddit = "all"
+	}
+
 	path := fmt.Sprintf("r/%s/search", subreddit)
 	path, err := addOptions(path, opts)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	notAll := !strings.EqualFold(subreddit, "all")
@@ -550,13 +710,51 @@ func (s *SubredditService) SearchPosts(ctx context.Context, query, searchType, s
 		RestrictSubreddits bool   `url:"restrict_sr,omitempty"`
 	}{query, searchType, notAll}
 
-	t, resp, err := s.client.getThing(ctx, path, params)
+	path, err = addOptions(path, params)
 	if err != nil {
-		return nil, resp, err
+		return nil, err
 	}
 
-	l, _ := t.Listing()
-	return l.Posts(), resp, nil
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, v)
+}
+
+// searchResults are the listings a search returns: one, or one per type of thing searched
+// for when searching for several.
+type searchResults []*listing
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *searchResults) UnmarshalJSON(b []byte) error {
+	var things []thing
+	if len(b) > 0 && b[0] == '[' {
+		if err := json.Unmarshal(b, &things); err != nil {
+			return err
+		}
+	} else {
+		things = make([]thing, 1)
+		if err := json.Unmarshal(b, &things[0]); err != nil {
+			return err
+		}
+	}
+
+	for i := range things {
+		if l, ok := things[i].Listing(); ok {
+			*r = append(*r, l)
+		}
+	}
+	return nil
+}
+
+// After returns the after anchor of the listing, if there's only one.
+func (r searchResults) After() string {
+	if len(r) != 1 {
+		return ""
+	}
+	return r[0].After()
 }
 
 func (s *SubredditService) getSubreddits(ctx context.Context, path string, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
@@ -567,6 +765,101 @@ func (s *SubredditService) getSubreddits(ctx context.Context, path string, opts
 	return l.Subreddits(), resp, nil
 }
 
+// maxStickies is the number of sticky slots a subreddit has, see GetSticky1 and GetSticky2.
+// Community highlights, which can hold up to 6 posts on the redesign, aren't covered: Reddit has
+// no public endpoint to list or arrange them, so ListPinned and ReorderPinned only manage the
+// legacy sticky slots.
+const maxStickies = 2
+
+// ListPinned returns the subreddit's stickied posts, in the order they are shown, in a single
+// request. These are the stickied posts at the top of the subreddit's hot listing, of which there
+// are at most 2. Unlike GetSticky1 and GetSticky2, it doesn't return the posts' comments.
+func (s *SubredditService) ListPinned(ctx context.Context, subreddit string) ([]*Post, *Response, error) {
+	posts, resp, err := s.HotPosts(ctx, subreddit, &ListOptions{Limit: maxStickies})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var pinned []*Post
+	for _, post := range posts {
+		if !post.Stickied {
+			break
+		}
+		pinned = append(pinned, post)
+	}
+
+	return pinned, resp, nil
+}
+
+// ReorderPinned makes the posts, via their full IDs, the subreddit's stickied posts, in the
+// given order. There can be at most 2. Stickied posts that aren't among them are unstickied.
+// Posts that are already in their place are left alone; the others are unstickied, and then
+// stickied again in order, one request at a time. If one of those requests fails, the
+// subreddit may be left with fewer stickied posts than asked for.
+func (s *SubredditService) ReorderPinned(ctx context.Context, subreddit string, ids ...string) (*Response, error) {
+	if len(ids) > maxStickies {
+		return nil, fmt.Errorf("ids: cannot pin more than %d posts", maxStickies)
+	}
+	if len(ids) == maxStickies && ids[0] == ids[1] {
+		return nil, errors.New("ids: cannot pin the same post twice")
+	}
+
+	pinned, resp, err := s.ListPinned(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+
+	// the posts before the first one out of place can stay
+	keep := 0
+	for keep < len(pinned) && keep < len(ids) && pinned[keep].FullID == ids[keep] {
+		keep++
+	}
+
+	for _, post := range pinned[keep:] {
+		resp, err = s.client.Post.Unsticky(ctx, post.FullID)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	// the slots after the kept posts are empty, so each post is stickied after the previous one
+	for _, id := range ids[keep:] {
+		resp, err = s.client.Post.Sticky(ctx, id, true)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// UpcomingEvents returns the event posts, e.g. scheduled AMAs, among the subreddit's newest posts
+// that are live or haven't started yet, ordered by when they start.
+// Reddit has no listing of a subreddit's events, so only the posts fetched with opts are searched.
+func (s *SubredditService) UpcomingEvents(ctx context.Context, subreddit string, opts *ListOptions) ([]*Post, *Response, error) {
+	posts, resp, err := s.NewPosts(ctx, subreddit, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	now := time.Now()
+	var events []*Post
+	for _, post := range posts {
+		if post.EventStart == nil {
+			continue
+		}
+		if post.EventIsLive || post.EventStart.After(now) || (post.EventEnd != nil && post.EventEnd.After(now)) {
+			events = append(events, post)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].EventStart.Before(events[j].EventStart.Time)
+	})
+
+	return events, resp, nil
+}
+
 // getSticky returns one of the 2 stickied posts of the subreddit (if they exist).
 // Num should be equal to 1 or 2, depending on which one you want.
 func (s *SubredditService) getSticky(ctx context.Context, subreddit string, num int) (*PostAndComments, *Response, error) {
@@ -726,7 +1019,7 @@ func (s *SubredditService) Muted(ctx context.Context, subreddit string, opts *Li
 	return root.Data.Relationships, resp, nil
 }
 
-// WikiBanned gets banned users from the subreddit.
+// WikiBanned gets users banned from contributing to the subreddit's wiki.
 func (s *SubredditService) WikiBanned(ctx context.Context, subreddit string, opts *ListOptions) ([]*Ban, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/wikibanned", subreddit)
 
@@ -815,8 +1108,18 @@ func (s *SubredditService) WikiContributors(ctx context.Context, subreddit strin
 
 // Moderators gets the moderators of the subreddit.
 func (s *SubredditService) Moderators(ctx context.Context, subreddit string) ([]*Moderator, *Response, error) {
+	return s.ListModerators(ctx, subreddit, nil)
+}
+
+// ListModerators gets the moderators of the subreddit, one page at a time.
+func (s *SubredditService) ListModerators(ctx context.Context, subreddit string, opts *ListRelationshipOptions) ([]*Moderator, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/moderators", subreddit)
 
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
@@ -825,6 +1128,7 @@ func (s *SubredditService) Moderators(ctx context.Context, subreddit string) ([]
 	root := new(struct {
 		Data struct {
 			Moderators []*Moderator `json:"children"`
+			After      string       `json:"after"`
 		} `json:"data"`
 	})
 	resp, err := s.client.Do(ctx, req, root)
@@ -832,7 +1136,19 @@ func (s *SubredditService) Moderators(ctx context.Context, subreddit string) ([]
 		return nil, resp, err
 	}
 
-	return root.Data.Moderators, resp, nil
+	resp.After = root.Data.After
+
+	moderators := root.Data.Moderators
+	if opts != nil && opts.ExcludeDeleted {
+		moderators = moderators[:0]
+		for _, moderator := range root.Data.Moderators {
+			if moderator.UserStatus() != RelationshipUserDeleted {
+				moderators = append(moderators, moderator)
+			}
+		}
+	}
+
+	return moderators, resp, nil
 }
 
 // Rules gets the rules of the subreddit.
@@ -1167,6 +1483,86 @@ func (s *SubredditService) GetSettings(ctx context.Context, subreddit string) (*
 	return settings, resp, nil
 }
 
+// UpdateSettings changes some of a subreddit's settings and leaves the rest as they are.
+// It gets the subreddit's current settings, lets update change them, and saves them via Edit,
+// so changes made by someone else in between the two requests are overwritten.
+func (s *SubredditService) UpdateSettings(ctx context.Context, subreddit string, update func(*SubredditSettings)) (*Response, error) {
+	if update == nil {
+		return nil, errors.New("update: cannot be nil")
+	}
+
+	settings, resp, err := s.GetSettings(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+	if settings == nil {
+		return resp, fmt.Errorf("subreddit %s: no settings returned", subreddit)
+	}
+
+	update(settings)
+	return s.Edit(ctx, settings.ID, settings)
+}
+
+// SetAllowImages sets whether the subreddit allows image uploads and links to image hosting sites.
+func (s *SubredditService) SetAllowImages(ctx context.Context, subreddit string, allow bool) (*Response, error) {
+	return s.UpdateSettings(ctx, subreddit, func(settings *SubredditSettings) {
+		settings.AllowImages = &allow
+	})
+}
+
+// SetShowMedia sets whether the subreddit shows thumbnails of its posts' content.
+func (s *SubredditService) SetShowMedia(ctx context.Context, subreddit string, show bool) (*Response, error) {
+	return s.UpdateSettings(ctx, subreddit, func(settings *SubredditSettings) {
+		settings.ShowContentThumbnails = &show
+	})
+}
+
+// SetNSFW sets whether viewers of the subreddit must be over 18 years old.
+func (s *SubredditService) SetNSFW(ctx context.Context, subreddit string, nsfw bool) (*Response, error) {
+	return s.UpdateSettings(ctx, subreddit, func(settings *SubredditSettings) {
+		settings.NSFW = &nsfw
+	})
+}
+
+// SetRestrictPosting sets whether only approved users can post to the subreddit, by making it
+// restricted if restrict is true, and public otherwise. Only public and restricted subreddits
+// can be switched this way; for others, e.g. private ones, an error is returned and nothing
+// is changed.
+func (s *SubredditService) SetRestrictPosting(ctx context.Context, subreddit string, restrict bool) (*Response, error) {
+	settings, resp, err := s.GetSettings(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+	if settings == nil {
+		return resp, fmt.Errorf("subreddit %s: no settings returned", subreddit)
+	}
+
+	if settings.Type == nil || (*settings.Type != "public" && *settings.Type != "restricted") {
+		current := ""
+		if settings.Type != nil {
+			current = *settings.Type
+		}
+		return resp, fmt.Errorf("subreddit %s: cannot restrict posting to a subreddit of type %q, only public and restricted ones", subreddit, current)
+	}
+
+	subredditType := "public"
+	if restrict {
+		subredditType = "restricted"
+	}
+	settings.Type = &subredditType
+
+	return s.Edit(ctx, settings.ID, settings)
+}
+
+// SetContributorRequests sets whether users can message the moderators of the subreddit to ask
+// to become approved users. Disabling them mutes that kind of modmail.
+func (s *SubredditService) SetContributorRequests(ctx context.Context, subreddit string, allow bool) (*Response, error) {
+	disable := !allow
+	return s.UpdateSettings(ctx, subreddit, func(settings *SubredditSettings) {
+		settings.DisableContributorRequests = &disable
+	})
+}
+
 // PostRequirements returns the subreddit's moderator-designed requirements to post to it.
 // Clients may use the values returned by this method to pre-validate submissions to the subreddit.
 func (s *SubredditService) PostRequirements(ctx context.Context, subreddit string) (*SubredditPostRequirements, *Response, error) {
@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -20,7 +21,6 @@ var expectedPosts = []*Post{
 		ID:      "agi5zf",
 		FullID:  "t3_agi5zf",
 		Created: &Timestamp{time.Date(2019, 1, 16, 5, 57, 51, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/agi5zf/test/",
 		URL:       "https://www.reddit.com/r/test/comments/agi5zf/test/",
@@ -42,12 +42,12 @@ var expectedPosts = []*Post{
 
 		IsSelfPost: true,
 		Stickied:   true,
+		Archived:   true,
 	},
 	{
 		ID:      "hyhquk",
 		FullID:  "t3_hyhquk",
 		Created: &Timestamp{time.Date(2020, 7, 27, 0, 5, 10, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/test/comments/hyhquk/veggies/",
 		URL:       "https://i.imgur.com/LrN2mPw.jpg",
@@ -65,6 +65,43 @@ var expectedPosts = []*Post{
 
 		Author:   "MuckleMcDuckle",
 		AuthorID: "t2_6fqntbwq",
+
+		Preview: &PostPreview{
+			Images: []*PreviewImage{
+				{
+					ID: "bxde3rpzP-mqawZJwpBIzEiH1y9nOLW3n1ghq9FPAR8",
+					Source: &PreviewSource{
+						URL:    "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?auto=webp&amp;s=f5103946eee4586cba8a1ba410e3098e9a14bb58",
+						Width:  720,
+						Height: 859,
+					},
+					Resolutions: []*PreviewSource{
+						{
+							URL:    "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=108&amp;crop=smart&amp;auto=webp&amp;s=a6904af790568dcea8fd3566e5d469df88a3891d",
+							Width:  108,
+							Height: 128,
+						},
+						{
+							URL:    "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=216&amp;crop=smart&amp;auto=webp&amp;s=09720b85b3b469b37030db3e3a5ab7fa231480f9",
+							Width:  216,
+							Height: 257,
+						},
+						{
+							URL:    "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=320&amp;crop=smart&amp;auto=webp&amp;s=78ace2e1c15e0e82dcfc95574d3ea3756812fd98",
+							Width:  320,
+							Height: 381,
+						},
+						{
+							URL:    "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=640&amp;crop=smart&amp;auto=webp&amp;s=d5d5305e3d97553176170ead8462cc0d155a7793",
+							Width:  640,
+							Height: 763,
+						},
+					},
+					Variants: map[string]*PreviewImage{},
+				},
+			},
+			Enabled: true,
+		},
 	},
 }
 
@@ -85,6 +122,14 @@ var expectedSubreddit = &Subreddit{
 	NSFW:            false,
 	UserIsMod:       false,
 	Subscribed:      true,
+
+	CommunityIconURL:    "https://styles.redditmedia.com/t5_2rc7j/styles/communityIcon_wy4riduoe9k11.png?width=256&amp;s=0d681daaa8d4b6271e6be788d0f9379f0661e04a",
+	BannerBackgroundURL: "https://styles.redditmedia.com/t5_2rc7j/styles/bannerBackgroundImage_k15p9ugyd9k11.png?width=4000&amp;s=dc19f23446f14c3dee0ab59c538fd5dfb243eeb9",
+	SubmissionType:      "any",
+	AllowImages:         true,
+	AllowVideos:         true,
+	AllowGalleries:      true,
+	AllowPolls:          true,
 }
 
 var expectedSubreddits = []*Subreddit{
@@ -104,6 +149,12 @@ var expectedSubreddits = []*Subreddit{
 		UserIsMod:   false,
 		Subscribed:  true,
 		Favorite:    false,
+
+		SubmissionType: "any",
+		AllowImages:    true,
+		AllowVideos:    true,
+		AllowGalleries: true,
+		AllowPolls:     true,
 	},
 	{
 		ID:      "2qh1i",
@@ -122,6 +173,15 @@ var expectedSubreddits = []*Subreddit{
 		UserIsMod:   false,
 		Subscribed:  true,
 		Favorite:    true,
+
+		IconURL:          "https://b.thumbs.redditmedia.com/EndDxMGB-FTZ2MGtjepQ06cQEkZw_YQAsOUudpb9nSQ.png",
+		CommunityIconURL: "https://styles.redditmedia.com/t5_2qh1i/styles/communityIcon_tijjpyw1qe201.png?width=256&amp;s=4e76eadc662b8155a93d4d7487a6d3acb35f4334",
+		BannerURL:        "https://b.thumbs.redditmedia.com/PXt8GnqdYu-9lgzb3iesJBLN21bXExRV1A45zdw4sYE.png",
+		PrimaryColor:     "#646d73",
+		KeyColor:         "#222222",
+		SubmissionType:   "self",
+		AllowGalleries:   true,
+		WikiEnabled:      Bool(true),
 	},
 	{
 		ID:      "2qh0u",
@@ -140,6 +200,14 @@ var expectedSubreddits = []*Subreddit{
 		UserIsMod:   false,
 		Subscribed:  false,
 		Favorite:    false,
+
+		IconURL:        "https://b.thumbs.redditmedia.com/VZX_KQLnI1DPhlEZ07bIcLzwR1Win808RIt7zm49VIQ.png",
+		PrimaryColor:   "#cee3f8",
+		KeyColor:       "#222222",
+		SubmissionType: "link",
+		AllowImages:    true,
+		AllowGalleries: true,
+		WikiEnabled:    Bool(true),
 	},
 }
 
@@ -158,7 +226,6 @@ var expectedSearchPosts = []*Post{
 		ID:      "hybow9",
 		FullID:  "t3_hybow9",
 		Created: &Timestamp{time.Date(2020, 7, 26, 18, 14, 24, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/WatchPeopleDieInside/comments/hybow9/pregnancy_test/",
 		URL:       "https://v.redd.it/ra4qnt8bt8d51",
@@ -176,12 +243,177 @@ var expectedSearchPosts = []*Post{
 
 		Author:   "chocolat_ice_cream",
 		AuthorID: "t2_3p32m02",
+
+		Awards: []*Award{
+			{
+				ID:          "award_9663243a-e77f-44cf-abc6-850ead2cd18d",
+				Name:        "Bravo Grande!",
+				Description: "For an especially amazing showing.",
+				CoinPrice:   75,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_a2506925-fc82-4d6c-ae3b-b7217e09d7f0",
+				Name:        "Narwhal Salute",
+				Description: "A golden splash of respect",
+				CoinPrice:   30,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/80j20o397jj41_NarwhalSalute.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_b4ff447e-05a5-42dc-9002-63568807cfe6",
+				Name:        "All-Seeing Upvote",
+				Description: "A glowing commendation for all to see",
+				CoinPrice:   30,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "gid_3",
+				Name:        "Platinum",
+				Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.",
+				CoinPrice:   1800,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/platinum_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "gid_2",
+				Name:        "Gold",
+				Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.",
+				CoinPrice:   500,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/gold_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       4,
+			},
+			{
+				ID:          "award_b28d9565-4137-433d-bb65-5d4aa82ade4c",
+				Name:        "I'm Deceased",
+				Description: "Call an ambulance, I'm laughing too hard.",
+				CoinPrice:   200,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       3,
+			},
+			{
+				ID:          "award_88fdcafc-57a0-48db-99cc-76276bfaf28b",
+				Name:        "Press F",
+				Description: "To pay respects.",
+				CoinPrice:   150,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_77ba55a2-c33c-4351-ac49-807455a80148",
+				Name:        "Bless Up",
+				Description: "Prayers up for the blessed.",
+				CoinPrice:   150,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/trfv6ems1md41_BlessUp.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "gid_1",
+				Name:        "Silver",
+				Description: "Shows the Silver Award... and that's it.",
+				CoinPrice:   100,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_7becef23-fb0b-4d62-b8a6-01d5759367cb",
+				Name:        "Faith In Humanity Restored",
+				Description: "When goodness lifts you",
+				CoinPrice:   70,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_02d9ab2c-162e-4c01-8438-317a016ed3d9",
+				Name:        "Take My Energy",
+				Description: "I'm in this with you.",
+				CoinPrice:   50,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       5,
+			},
+			{
+				ID:          "award_69c94eb4-d6a3-48e7-9cf2-0f39fed8b87c",
+				Name:        "Ally",
+				Description: "Listen, get educated, and get involved.",
+				CoinPrice:   50,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/5nswjpyy44551_Ally.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+		},
+
+		Preview: &PostPreview{
+			Images: []*PreviewImage{
+				{
+					ID: "6MEEtWN_cm1lRDpu_daXxHcau23YIWh0FeiB96IPgJs",
+					Source: &PreviewSource{
+						URL:    "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?format=pjpg&amp;auto=webp&amp;s=dbe1004d6df4fb6014d78e0c0d817c1106f1f3b2",
+						Width:  360,
+						Height: 360,
+					},
+					Resolutions: []*PreviewSource{
+						{
+							URL:    "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=108&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=3de4a7249f291b848838f865bb592f7e51555e96",
+							Width:  108,
+							Height: 108,
+						},
+						{
+							URL:    "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=216&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=531916387899ed20e33386081b5d5c58a73be188",
+							Width:  216,
+							Height: 216,
+						},
+						{
+							URL:    "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=320&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=4d19996fba95dae7fb615cdc102d34c8bfb44e0a",
+							Width:  320,
+							Height: 320,
+						},
+					},
+					Variants: map[string]*PreviewImage{},
+				},
+			},
+		},
+		Media: &PostMedia{
+			RedditVideo: &RedditVideo{
+				FallbackURL:       "https://v.redd.it/ra4qnt8bt8d51/DASH_360.mp4?source=fallback",
+				HLSURL:            "https://v.redd.it/ra4qnt8bt8d51/HLSPlaylist.m3u8?a=1598576219%2CNTlmNTJhZDAyMTY4ZDAzNmM1NzAxMTYxZTNmYTk1OTJkYzI3MWEyYjNmNDdmYWU2MWY5ZjUwMzFkODA2YWY1ZQ%3D%3D&amp;v=1&amp;f=sd",
+				DASHURL:           "https://v.redd.it/ra4qnt8bt8d51/DASHPlaylist.mpd?a=1598576219%2CZjZhYTZlMTYxOTU2MjQzNTBlMmZmMjRiNDRlNDYxM2NjNjZiZjM2NzQxYTA5MTdhMGQyODBmNGJiYjYyOGFjMw%3D%3D&amp;v=1&amp;f=sd",
+				ScrubberMediaURL:  "https://v.redd.it/ra4qnt8bt8d51/DASH_96.mp4",
+				Width:             360,
+				Height:            360,
+				Duration:          230,
+				TranscodingStatus: "completed",
+			},
+		},
 	},
 	{
 		ID:      "hmwhd7",
 		FullID:  "t3_hmwhd7",
 		Created: &Timestamp{time.Date(2020, 7, 7, 15, 19, 42, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/worldnews/comments/hmwhd7/brazilian_president_jair_bolsonaro_tests_positive/",
 		URL:       "https://www.theguardian.com/world/2020/jul/07/jair-bolsonaro-coronavirus-positive-test-brazil-president",
@@ -199,6 +431,292 @@ var expectedSearchPosts = []*Post{
 
 		Author:   "Jeremy_Martin",
 		AuthorID: "t2_wgrkg",
+
+		Awards: []*Award{
+			{
+				ID:          "award_6001deaa-c9e0-4914-ab3d-7c4a16bd8617",
+				Name:        "Fireworks",
+				Description: "Bonfires and illuminations are still going strong. Happy 4th of July!",
+				CoinPrice:   100,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/Fireworks_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_92cb6518-a71a-4217-9f8f-7ecbd7ab12ba",
+				Name:        "Take My Power",
+				Description: "Add my power to yours.",
+				CoinPrice:   75,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/TakeMyPower_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "award_9663243a-e77f-44cf-abc6-850ead2cd18d",
+				Name:        "Bravo Grande!",
+				Description: "For an especially amazing showing.",
+				CoinPrice:   75,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_c4b2e438-16bb-4568-88e7-7893b7662944",
+				Name:        "Wholesome Seal of Approval",
+				Description: "A glittering stamp for a feel-good thing",
+				CoinPrice:   30,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/b9ks3a5k7jj41_WholesomeSealofApproval.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_b4ff447e-05a5-42dc-9002-63568807cfe6",
+				Name:        "All-Seeing Upvote",
+				Description: "A glowing commendation for all to see",
+				CoinPrice:   30,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "award_d48aad4b-286f-4a3a-bb41-ec05b3cd87cc",
+				Name:        "Yas Queen",
+				Description: "YAAAAAAAAAAASSS.",
+				CoinPrice:   250,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/kthj3e4h3bm41_YasQueen.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "gid_3",
+				Name:        "Platinum",
+				Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.",
+				CoinPrice:   1800,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/platinum_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "gid_2",
+				Name:        "Gold",
+				Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.",
+				CoinPrice:   500,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/gold_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       3,
+			},
+			{
+				ID:          "award_43c43a35-15c5-4f73-91ef-fe538426435a",
+				Name:        "Bless Up (Pro)",
+				Description: "Prayers up for the blessed. Gives %{coin_symbol}100 Coins to both the author and the community.",
+				CoinPrice:   500,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/xe5mw55w5v541_BlessUp.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_5b39e8fd-7a58-4cbe-8ca0-bdedd5ed1f5a",
+				Name:        "Doot 🎵 Doot",
+				Description: "Sometimes you just got to dance with the doots.",
+				CoinPrice:   400,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/Updoot_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       6,
+			},
+			{
+				ID:          "award_725b427d-320b-4d02-8fb0-8bb7aa7b78aa",
+				Name:        "Updoot",
+				Description: "Sometimes you just got to doot.",
+				CoinPrice:   300,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/7atjjqpy1mc41_Updoot.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_d125d124-5c03-490d-af3d-d07c462003da",
+				Name:        "Stonks Rising",
+				Description: "To the MOON.",
+				CoinPrice:   200,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/s5edqq9abef41_StonksRising.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "award_b28d9565-4137-433d-bb65-5d4aa82ade4c",
+				Name:        "I'm Deceased",
+				Description: "Call an ambulance, I'm laughing too hard.",
+				CoinPrice:   200,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       7,
+			},
+			{
+				ID:          "award_88fdcafc-57a0-48db-99cc-76276bfaf28b",
+				Name:        "Press F",
+				Description: "To pay respects.",
+				CoinPrice:   150,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       4,
+			},
+			{
+				ID:          "award_5f123e3d-4f48-42f4-9c11-e98b566d5897",
+				Name:        "Wholesome",
+				Description: "When you come across a feel-good thing.",
+				CoinPrice:   125,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/5izbv4fn0md41_Wholesome.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       5,
+			},
+			{
+				ID:          "gid_1",
+				Name:        "Silver",
+				Description: "Shows the Silver Award... and that's it.",
+				CoinPrice:   100,
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "award_99d95969-6100-45b2-b00c-0ec45ae19596",
+				Name:        "Snek",
+				Description: "A smol, delicate danger noodle.",
+				CoinPrice:   70,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/rc5iesz2z8t41_Snek.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       1,
+			},
+			{
+				ID:          "award_7becef23-fb0b-4d62-b8a6-01d5759367cb",
+				Name:        "Faith In Humanity Restored",
+				Description: "When goodness lifts you",
+				CoinPrice:   70,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "award_b1b44fa1-8179-4d84-a9ed-f25bb81f1c5f",
+				Name:        "Facepalm",
+				Description: "*Lowers face into palm*",
+				CoinPrice:   70,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/ey2iodron2s41_Facepalm.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       3,
+			},
+			{
+				ID:          "award_02d9ab2c-162e-4c01-8438-317a016ed3d9",
+				Name:        "Take My Energy",
+				Description: "I'm in this with you.",
+				CoinPrice:   50,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       2,
+			},
+			{
+				ID:          "award_fcccaa58-8f63-4d9d-9251-81033cd0daa3",
+				Name:        "Nothing To Do",
+				Description: "I've got nothing to do, and I'm trying to do nothing.",
+				CoinPrice:   50,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/1snr345pm1w41_NothingToDo.png",
+				Type:        "global",
+				Count:       1,
+			},
+			{
+				ID:          "award_cc091963-e271-45aa-ba23-b5150e565520",
+				Name:        "Safe &amp; Social",
+				Description: "Connecting together responsibly",
+				CoinPrice:   30,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/qq73pijkm3p41_SafeSocial.png",
+				Type:        "global",
+				Count:       1,
+			},
+			{
+				ID:          "award_3cf96da4-79da-4127-90ac-84545e1833dc",
+				Name:        "Home Time",
+				Description: "Staying home &amp; being safe when you can",
+				CoinPrice:   30,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/qh4pzo76v9p41_HomeTime.png",
+				Type:        "global",
+				Count:       2,
+			},
+			{
+				ID:          "award_a903c949-ccc5-420d-8239-1bbefc424838",
+				Name:        "Healthcare Hero",
+				Description: "Putting yourself on the line for us - you are the perfect super hero!",
+				CoinPrice:   30,
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/xs2na1t1v9p41_HealthcareHero.png",
+				Type:        "global",
+				IsEnabled:   true,
+				Count:       7,
+			},
+		},
+
+		Preview: &PostPreview{
+			Images: []*PreviewImage{
+				{
+					ID: "Ug52cYq0iihKhNVnhJnu_b8ThcVTp27Yjit2korgoUo",
+					Source: &PreviewSource{
+						URL:    "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?auto=webp&amp;s=bcb266e3d2f9b1b8410b8ebc1ba112461ac7c89b",
+						Width:  1200,
+						Height: 630,
+					},
+					Resolutions: []*PreviewSource{
+						{
+							URL:    "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=108&amp;crop=smart&amp;auto=webp&amp;s=8cd17cff83d56ad74566088b46a5f656c4e6233b",
+							Width:  108,
+							Height: 56,
+						},
+						{
+							URL:    "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=216&amp;crop=smart&amp;auto=webp&amp;s=279340e68ef64a890709218d27e805e40ef2d1d5",
+							Width:  216,
+							Height: 113,
+						},
+						{
+							URL:    "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=320&amp;crop=smart&amp;auto=webp&amp;s=a57f95db845046e7d75af256fed8a2fab65dec60",
+							Width:  320,
+							Height: 168,
+						},
+						{
+							URL:    "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=640&amp;crop=smart&amp;auto=webp&amp;s=6fc8a7055610d03faaa3b0f32ba521a99b5c2bdd",
+							Width:  640,
+							Height: 336,
+						},
+						{
+							URL:    "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=960&amp;crop=smart&amp;auto=webp&amp;s=be77436ac80c45b2153de325008085920d8d8489",
+							Width:  960,
+							Height: 504,
+						},
+						{
+							URL:    "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=1080&amp;crop=smart&amp;auto=webp&amp;s=71644306bcb0036f2d8ee5bf878e3c78f6c3012c",
+							Width:  1080,
+							Height: 567,
+						},
+					},
+					Variants: map[string]*PreviewImage{},
+				},
+			},
+		},
 	},
 }
 
@@ -214,6 +732,9 @@ var expectedRandomSubreddit = &Subreddit{
 	Type:         "public",
 
 	Subscribers: 52357,
+
+	IconURL:      "https://b.thumbs.redditmedia.com/4hg41g2_X1R5S_HTUscWCK_7iAo6SPdag_oOlSx7WAM.png",
+	PrimaryColor: "#373c3f",
 }
 
 var expectedRelationships3 = []*Relationship{
@@ -361,8 +882,11 @@ var expectedSubredditSettings = &SubredditSettings{
 	AllowMultipleImagesPerPost: Bool(true),
 
 	ExcludeSitewideBannedUsersContent: Bool(false),
+	DisableContributorRequests:        Bool(false),
 
 	CrowdControlChalLevel: Int(2),
+	CrowdControlMode:      Bool(false),
+	CrowdControlLevel:     Int(0),
 
 	AllOriginalContent: Bool(false),
 
@@ -468,6 +992,33 @@ func TestSubredditService_NewPosts(t *testing.T) {
 	require.Equal(t, "t3_hyhquk", resp.After)
 }
 
+func TestSubredditService_NewPosts_Pagination(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "2")
+		form.Set("after", "t3_before")
+		form.Set("count", "25")
+		form.Set("show", "all")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, resp, err := client.Subreddit.NewPosts(ctx, "test", &ListOptions{Limit: 2, After: "t3_before", Count: 25, Show: "all"})
+	require.NoError(t, err)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
 func TestSubredditService_RisingPosts(t *testing.T) {
 	client, mux := setup(t)
 
@@ -538,6 +1089,29 @@ func TestSubredditService_Get(t *testing.T) {
 	require.Equal(t, expectedSubreddit, subreddit)
 }
 
+func TestSubredditService_Get_Quarantined(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{
+			"reason": "quarantined",
+			"quarantine_message": "This community is quarantined.",
+			"message": "Forbidden",
+			"error": 403
+		}`)
+	})
+
+	_, _, err := client.Subreddit.Get(ctx, "testsubreddit")
+	require.True(t, errors.Is(err, ErrQuarantined))
+
+	var quarantinedErr *QuarantinedError
+	require.True(t, errors.As(err, &quarantinedErr))
+	require.Equal(t, "This community is quarantined.", quarantinedErr.Message)
+	require.EqualError(t, err, fmt.Sprintf("GET %s/r/testsubreddit/about: 403 subreddit is quarantined", client.BaseURL))
+}
+
 func TestSubredditService_Popular(t *testing.T) {
 	client, mux := setup(t)
 
@@ -775,6 +1349,42 @@ func TestSubredditService_UnsubscribeByID(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSubredditService_OptInQuarantine(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/quarantine_optin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("sr_name", "testsubreddit")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.OptInQuarantine(ctx, "testsubreddit")
+	require.NoError(t, err)
+}
+
+func TestSubredditService_OptOutQuarantine(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/quarantine_optout", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("sr_name", "testsubreddit")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.OptOutQuarantine(ctx, "testsubreddit")
+	require.NoError(t, err)
+}
+
 func TestSubredditService_Favorite(t *testing.T) {
 	client, mux := setup(t)
 
@@ -871,6 +1481,46 @@ func TestSubredditService_SearchNames(t *testing.T) {
 	require.Equal(t, expectedSubredditNames, names)
 }
 
+func TestSubredditService_Trending(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/trending.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/trending_subreddits", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	names, _, err := client.Subreddit.Trending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"AnimalsBeingDerps", "golang", "TestSubreddit", "space", "nba"}, names)
+}
+
+func TestSubredditService_Recommended(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/recommend/sr/golang,rust", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("omit", "programming,learnprogramming")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, `[{"sr_name": "golang_jobs"}, {"sr_name": "cpp"}]`)
+	})
+
+	_, _, err := client.Subreddit.Recommended(ctx, nil, nil)
+	require.EqualError(t, err, "must provide at least 1 subreddit")
+
+	names, _, err := client.Subreddit.Recommended(ctx, []string{"golang", "rust"}, []string{"programming", "learnprogramming"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"golang_jobs", "cpp"}, names)
+}
+
 func TestSubredditService_SearchPosts(t *testing.T) {
 	client, mux := setup(t)
 
@@ -896,6 +1546,53 @@ func TestSubredditService_SearchPosts(t *testing.T) {
 	require.Equal(t, "t3_hmwhd7", resp.After)
 }
 
+func TestSubredditService_SearchThings(t *testing.T) {
+	client, mux := setup(t)
+
+	postsBlob, err := readFileContents("../testdata/subreddit/search-posts.json")
+	require.NoError(t, err)
+	subredditsBlob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/all/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "test", r.Form.Get("q"))
+
+		// a listing per type when searching for several
+		if r.Form.Get("type") == "link,sr" {
+			fmt.Fprintf(w, "[%s, %s]", postsBlob, subredditsBlob)
+			return
+		}
+		require.Equal(t, "link", r.Form.Get("type"))
+		fmt.Fprint(w, postsBlob)
+	})
+
+	_, _, err = client.Subreddit.SearchThings(ctx, "test", "", "", nil)
+	require.EqualError(t, err, "searchType: cannot be empty")
+
+	things, resp, err := client.Subreddit.SearchThings(ctx, "test", "link", "", nil)
+	require.NoError(t, err)
+	require.Len(t, things, len(expectedSearchPosts))
+	for i, post := range expectedSearchPosts {
+		require.Equal(t, post, things[i])
+	}
+	require.Equal(t, "t3_hmwhd7", resp.After)
+
+	things, resp, err = client.Subreddit.SearchThings(ctx, "test", "link,sr", "", nil)
+	require.NoError(t, err)
+	require.Len(t, things, len(expectedSearchPosts)+len(expectedSubreddits))
+	for i, post := range expectedSearchPosts {
+		require.Equal(t, post, things[i])
+	}
+	for i, subreddit := range expectedSubreddits {
+		require.Equal(t, subreddit, things[len(expectedSearchPosts)+i])
+	}
+	require.Empty(t, resp.After)
+}
+
 func TestSubredditService_SearchPostsAsync(t *testing.T) {
 	client, mux := setupAsync(t)
 
@@ -973,6 +1670,88 @@ func TestSubredditService_SearchPosts_InSubreddits(t *testing.T) {
 	require.Equal(t, "t3_hmwhd7", resp.After)
 }
 
+func TestSubredditService_ListPinned(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "2", r.Form.Get("limit"))
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Subreddit.ListPinned(ctx, "test")
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	require.Equal(t, "t3_agi5zf", posts[0].FullID)
+}
+
+func TestSubredditService_GetStickies(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "2", r.Form.Get("limit"))
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Subreddit.GetStickies(ctx, "test")
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	require.Equal(t, "t3_agi5zf", posts[0].FullID)
+}
+
+func TestSubredditService_ReorderPinned(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	var forms []url.Values
+	mux.HandleFunc("/api/set_subreddit_sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		forms = append(forms, r.PostForm)
+	})
+
+	_, err = client.Subreddit.ReorderPinned(ctx, "test", "t3_1", "t3_2", "t3_3")
+	require.EqualError(t, err, "ids: cannot pin more than 2 posts")
+	_, err = client.Subreddit.ReorderPinned(ctx, "test", "t3_1", "t3_1")
+	require.EqualError(t, err, "ids: cannot pin the same post twice")
+	require.Empty(t, forms)
+
+	// t3_agi5zf is the only stickied post
+	_, err = client.Subreddit.ReorderPinned(ctx, "test", "t3_agi5zf", "t3_hyhquk")
+	require.NoError(t, err)
+	require.Len(t, forms, 1)
+	require.Equal(t, "t3_hyhquk", forms[0].Get("id"))
+	require.Equal(t, "true", forms[0].Get("state"))
+
+	forms = nil
+	_, err = client.Subreddit.ReorderPinned(ctx, "test", "t3_hyhquk", "t3_agi5zf")
+	require.NoError(t, err)
+	require.Len(t, forms, 3)
+
+	require.Equal(t, "t3_agi5zf", forms[0].Get("id"))
+	require.Equal(t, "false", forms[0].Get("state"))
+	require.Equal(t, "t3_hyhquk", forms[1].Get("id"))
+	require.Equal(t, "true", forms[1].Get("state"))
+	require.Equal(t, "t3_agi5zf", forms[2].Get("id"))
+	require.Equal(t, "true", forms[2].Get("state"))
+}
+
 func TestSubredditService_Random(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1172,6 +1951,81 @@ func TestSubredditService_Moderators(t *testing.T) {
 	require.Equal(t, expectedModerators, moderators)
 }
 
+func TestSubredditService_ListModerators(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/moderators.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/about/moderators", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "2")
+		form.Set("after", "rb_abc")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	moderators, _, err := client.Subreddit.ListModerators(ctx, "test", &ListRelationshipOptions{
+		ListOptions: ListOptions{Limit: 2, After: "rb_abc"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedModerators, moderators)
+}
+
+func TestSubredditService_ListModerators_ExcludeDeleted(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/moderators-deleted.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/about/moderators", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Empty(t, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	moderators, _, err := client.Subreddit.ListModerators(ctx, "test", nil)
+	require.NoError(t, err)
+	require.Len(t, moderators, 2)
+	require.Equal(t, RelationshipUserActive, moderators[0].UserStatus())
+	require.Equal(t, RelationshipUserDeleted, moderators[1].UserStatus())
+
+	moderators, _, err = client.Subreddit.ListModerators(ctx, "test", &ListRelationshipOptions{ExcludeDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, expectedModerators[:1], moderators)
+}
+
+func TestModerator_ModPermissions(t *testing.T) {
+	moderator := &Moderator{Permissions: []string{"posts", "wiki"}}
+	require.Equal(t, &ModPermissions{Posts: true, Wiki: true}, moderator.ModPermissions())
+
+	moderator = &Moderator{Permissions: []string{"all"}}
+	require.Equal(t, &ModPermissions{
+		All:          true,
+		Access:       true,
+		ChatConfig:   true,
+		ChatOperator: true,
+		Config:       true,
+		Flair:        true,
+		Mail:         true,
+		Posts:        true,
+		Wiki:         true,
+	}, moderator.ModPermissions())
+
+	moderator = &Moderator{}
+	require.Equal(t, &ModPermissions{}, moderator.ModPermissions())
+}
+
 func TestSubredditService_Rules(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1619,7 +2473,10 @@ func TestSubredditService_Create(t *testing.T) {
 		form.Set("allow_images", "true")
 		form.Set("allow_galleries", "true")
 		form.Set("exclude_banned_modqueue", "false")
+		form.Set("disable_contributor_requests", "false")
 		form.Set("crowd_control_chat_level", "2")
+		form.Set("crowd_control_mode", "false")
+		form.Set("crowd_control_level", "0")
 		form.Set("all_original_content", "false")
 		form.Set("submit_link_label", "submit a link!")
 		form.Set("submit_text_label", "submit a post!")
@@ -1679,7 +2536,10 @@ func TestSubredditService_Edit(t *testing.T) {
 		form.Set("allow_images", "true")
 		form.Set("allow_galleries", "true")
 		form.Set("exclude_banned_modqueue", "false")
+		form.Set("disable_contributor_requests", "false")
 		form.Set("crowd_control_chat_level", "2")
+		form.Set("crowd_control_mode", "false")
+		form.Set("crowd_control_level", "0")
 		form.Set("all_original_content", "false")
 		form.Set("submit_link_label", "submit a link!")
 		form.Set("submit_text_label", "submit a post!")
@@ -1730,6 +2590,83 @@ func TestSubredditService_GetSettings(t *testing.T) {
 	require.Equal(t, expectedSubredditSettings, subredditSettings)
 }
 
+func TestSubredditService_UpdateSettings(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/settings.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	var form url.Values
+	mux.HandleFunc("/api/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		form = r.PostForm
+	})
+
+	_, err = client.Subreddit.UpdateSettings(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "update: cannot be nil")
+
+	_, err = client.Subreddit.SetNSFW(ctx, "testsubreddit", true)
+	require.NoError(t, err)
+	require.Equal(t, "t5_test", form.Get("sr"))
+	require.Equal(t, "true", form.Get("over_18"))
+	require.Equal(t, "private", form.Get("type"))
+	require.Equal(t, "true", form.Get("allow_images"))
+
+	_, err = client.Subreddit.SetAllowImages(ctx, "testsubreddit", false)
+	require.NoError(t, err)
+	require.Equal(t, "false", form.Get("allow_images"))
+	require.Equal(t, "false", form.Get("over_18"))
+
+	_, err = client.Subreddit.SetShowMedia(ctx, "testsubreddit", true)
+	require.NoError(t, err)
+	require.Equal(t, "true", form.Get("show_media"))
+
+	_, err = client.Subreddit.SetContributorRequests(ctx, "testsubreddit", false)
+	require.NoError(t, err)
+	require.Equal(t, "true", form.Get("disable_contributor_requests"))
+}
+
+func TestSubredditService_SetRestrictPosting(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/settings.json")
+	require.NoError(t, err)
+
+	subredditType := "private"
+	mux.HandleFunc("/r/testsubreddit/about/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, strings.Replace(blob, `"subreddit_type": "private"`, fmt.Sprintf(`"subreddit_type": %q`, subredditType), 1))
+	})
+
+	var form url.Values
+	mux.HandleFunc("/api/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		form = r.PostForm
+	})
+
+	// private subreddits aren't made public
+	_, err = client.Subreddit.SetRestrictPosting(ctx, "testsubreddit", false)
+	require.EqualError(t, err, `subreddit testsubreddit: cannot restrict posting to a subreddit of type "private", only public and restricted ones`)
+	require.Nil(t, form)
+
+	subredditType = "public"
+	_, err = client.Subreddit.SetRestrictPosting(ctx, "testsubreddit", true)
+	require.NoError(t, err)
+	require.Equal(t, "restricted", form.Get("type"))
+
+	subredditType = "restricted"
+	_, err = client.Subreddit.SetRestrictPosting(ctx, "testsubreddit", false)
+	require.NoError(t, err)
+	require.Equal(t, "public", form.Get("type"))
+}
+
 func TestSubredditService_PostRequirements(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1745,3 +2682,33 @@ func TestSubredditService_PostRequirements(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedSubredditPostRequirements, postRequirements)
 }
+
+func TestSubredditService_UpcomingEvents(t *testing.T) {
+	client, mux := setup(t)
+
+	now := time.Now().Unix()
+	mux.HandleFunc("/r/test/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprintf(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t3", "data": {"name": "t3_later", "event_start": %d, "event_end": %d, "event_is_live": false}},
+					{"kind": "t3", "data": {"name": "t3_past", "event_start": %d, "event_end": %d, "event_is_live": false}},
+					{"kind": "t3", "data": {"name": "t3_live", "event_start": %d, "event_end": %d, "event_is_live": true}},
+					{"kind": "t3", "data": {"name": "t3_notevent"}},
+					{"kind": "t3", "data": {"name": "t3_soon", "event_start": %d, "event_end": %d, "event_is_live": false}}
+				]
+			}
+		}`, now+7200, now+10800, now-7200, now-3600, now-600, now+600, now+3600, now+7200)
+	})
+
+	posts, _, err := client.Subreddit.UpcomingEvents(ctx, "test", nil)
+	require.NoError(t, err)
+	require.Len(t, posts, 3)
+	require.Equal(t, "t3_live", posts[0].FullID)
+	require.True(t, posts[0].EventIsLive)
+	require.Equal(t, "t3_soon", posts[1].FullID)
+	require.Equal(t, "t3_later", posts[2].FullID)
+	require.Equal(t, now+10800, posts[2].EventEnd.Unix())
+}
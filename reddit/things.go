@@ -3,6 +3,9 @@ package reddit
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 )
 
 const (
@@ -33,6 +36,55 @@ type anchor interface {
 	After() string
 }
 
+// Thing is something Reddit lists, i.e. a comment, user, post, message or subreddit.
+// Listings that mix kinds of things, such as a moderation queue or a user's overview, can be
+// got as a []Thing in the order Reddit sent them, and the things told apart with a type switch:
+//
+//	for _, thing := range things {
+//		switch v := thing.(type) {
+//		case *reddit.Post:
+//			fmt.Println("post", v.Title)
+//		case *reddit.Comment:
+//			fmt.Println("comment", v.Body)
+//		}
+//	}
+type Thing interface {
+	// Kind returns the kind of the thing, e.g. KindPost.
+	Kind() string
+	// Fullname returns the full ID of the thing, e.g. t3_abc123.
+	Fullname() string
+}
+
+// Kind returns KindComment.
+func (c *Comment) Kind() string { return kindComment }
+
+// Fullname returns the full ID of the comment.
+func (c *Comment) Fullname() string { return c.FullID }
+
+// Kind returns KindUser.
+func (u *User) Kind() string { return kindUser }
+
+// Fullname returns the full ID of the user.
+func (u *User) Fullname() string { return UserFullname(u.ID) }
+
+// Kind returns KindPost.
+func (p *Post) Kind() string { return kindPost }
+
+// Fullname returns the full ID of the post.
+func (p *Post) Fullname() string { return p.FullID }
+
+// Kind returns KindMessage.
+func (m *Message) Kind() string { return kindMessage }
+
+// Fullname returns the full ID of the message.
+func (m *Message) Fullname() string { return m.FullID }
+
+// Kind returns KindSubreddit.
+func (s *Subreddit) Kind() string { return kindSubreddit }
+
+// Fullname returns the full ID of the subreddit.
+func (s *Subreddit) Fullname() string { return s.FullID }
+
 type JobResponse struct {
 	JobID string `json:"job_id"`
 }
@@ -84,6 +136,8 @@ func (t *thing) UnmarshalJSON(b []byte) error {
 		v = new(User)
 	case kindPost:
 		v = new(Post)
+	case kindMessage:
+		v = new(Message)
 	case kindSubreddit:
 		v = new(Subreddit)
 	case kindSubredditSettings:
@@ -240,6 +294,9 @@ type listing struct {
 }
 
 func (l *listing) After() string {
+	if l == nil {
+		return ""
+	}
 	return l.after
 }
 
@@ -261,6 +318,14 @@ func (l *listing) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Things returns the things in the listing that implement Thing, in the order they were listed.
+func (l *listing) Things() []Thing {
+	if l == nil {
+		return nil
+	}
+	return l.things.Things
+}
+
 func (l *listing) Comments() []*Comment {
 	if l == nil {
 		return nil
@@ -334,6 +399,9 @@ type things struct {
 	Multis            []*Multi
 	LiveThreads       []*LiveThread
 	LiveThreadUpdates []*LiveThreadUpdate
+
+	// All of the above that implement Thing, in order.
+	Things []Thing
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -349,6 +417,9 @@ func (t *things) UnmarshalJSON(b []byte) error {
 
 func (t *things) add(things ...thing) {
 	for _, thing := range things {
+		if v, ok := thing.Data.(Thing); ok {
+			t.Things = append(t.Things, v)
+		}
 		switch v := thing.Data.(type) {
 		case *Comment:
 			t.Comments = append(t.Comments, v)
@@ -400,7 +471,8 @@ type Comment struct {
 	ID      string     `json:"id,omitempty"`
 	FullID  string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
-	Edited  *Timestamp `json:"edited,omitempty"`
+	// Nil if it hasn't been edited.
+	Edited *Timestamp `json:"edited,omitempty"`
 
 	ParentID  string `json:"parent_id,omitempty"`
 	Permalink string `json:"permalink,omitempty"`
@@ -410,6 +482,13 @@ type Comment struct {
 	AuthorID        string `json:"author_fullname,omitempty"`
 	AuthorFlairText string `json:"author_flair_text,omitempty"`
 	AuthorFlairID   string `json:"author_flair_template_id,omitempty"`
+	// One of: text, richtext.
+	AuthorFlairType string `json:"author_flair_type,omitempty"`
+	// The author flair's text and emojis, if its type is richtext.
+	AuthorFlairRichText        []map[string]string `json:"author_flair_richtext,omitempty"`
+	AuthorFlairCSSClass        string              `json:"author_flair_css_class,omitempty"`
+	AuthorFlairTextColor       string              `json:"author_flair_text_color,omitempty"`
+	AuthorFlairBackgroundColor string              `json:"author_flair_background_color,omitempty"`
 
 	SubredditName         string `json:"subreddit,omitempty"`
 	SubredditNamePrefixed string `json:"subreddit_name_prefixed,omitempty"`
@@ -422,6 +501,16 @@ type Comment struct {
 	Score            int `json:"score"`
 	Controversiality int `json:"controversiality"`
 
+	// One of: moderator, admin, special. Empty if the comment isn't distinguished.
+	Distinguished string `json:"distinguished,omitempty"`
+
+	// How deep the comment is in the thread it was fetched with, 0 being a top-level comment.
+	// Only set for comments fetched as part of a thread.
+	Depth int `json:"depth,omitempty"`
+	// Whether the comment is collapsed by default, e.g. because of its low score.
+	Collapsed       bool   `json:"collapsed"`
+	CollapsedReason string `json:"collapsed_reason,omitempty"`
+
 	PostID string `json:"link_id,omitempty"`
 	// This doesn't appear consistently.
 	PostTitle string `json:"link_title,omitempty"`
@@ -437,10 +526,51 @@ type Comment struct {
 	Saved       bool `json:"saved"`
 	Stickied    bool `json:"stickied"`
 	Locked      bool `json:"locked"`
+	Archived    bool `json:"archived"`
 	CanGild     bool `json:"can_gild"`
 	NSFW        bool `json:"over_18"`
 
+	// Media embedded in the comment's body, e.g. GIFs, emotes and images, keyed by their ID.
+	MediaMetadata map[string]*MediaMetadata `json:"media_metadata,omitempty"`
+
+	// The awards the comment was given.
+	Awards []*Award `json:"all_awardings,omitempty"`
+
+	// If the comment was removed and you're allowed to see by whom, e.g. you're a moderator.
+	Removal *Removal `json:"-"`
+
 	Replies Replies `json:"replies"`
+
+	// The data of the comment as Reddit sent it, if the client was created with WithRawThingJSON.
+	// Useful for fields the library doesn't model yet.
+	RawJSON json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *Comment) UnmarshalJSON(b []byte) error {
+	type comment Comment
+	root := struct {
+		*comment
+		removalFields
+	}{comment: (*comment)(c)}
+
+	err := json.Unmarshal(b, &root)
+	if err != nil {
+		return err
+	}
+
+	c.Removal = root.removalFields.removal()
+	if c.Edited != nil && c.Edited.IsZero() {
+		// Reddit sends false for comments that haven't been edited.
+		c.Edited = nil
+	}
+	if len(c.Awards) == 0 {
+		c.Awards = nil
+	}
+	if len(c.AuthorFlairRichText) == 0 {
+		c.AuthorFlairRichText = nil
+	}
+	return nil
 }
 
 // HasMore determines whether the comment has more replies to load in its reply tree.
@@ -472,6 +602,104 @@ func (c *Comment) addMoreToReplies(more *More) {
 	}
 }
 
+// mediaRegexp matches the markdown Reddit puts in a comment's body for embedded media,
+// e.g. ![gif](giphy|abc123|downsized) or ![img](emote|t5_2qh1i|1234).
+var mediaRegexp = regexp.MustCompile(`!\[(?:gif|img)\]\(([^)\s]+)\)`)
+
+// ReplaceMedia returns the comment's body with the markdown of each embedded media item
+// replaced by what replace returns for it, e.g. a URL or an empty string to strip it.
+// Markdown referring to media missing from MediaMetadata is left as is.
+func (c *Comment) ReplaceMedia(replace func(media *MediaMetadata) string) string {
+	return mediaRegexp.ReplaceAllStringFunc(c.Body, func(s string) string {
+		id := mediaRegexp.FindStringSubmatch(s)[1]
+
+		media, ok := c.MediaMetadata[id]
+		if !ok && strings.HasPrefix(id, "giphy|") {
+			// GIFs can have a size suffix, e.g. giphy|abc123|downsized.
+			if i := strings.LastIndex(id, "|"); i > len("giphy") {
+				media, ok = c.MediaMetadata[id[:i]]
+			}
+		}
+		if !ok {
+			return s
+		}
+
+		return replace(media)
+	})
+}
+
+// MediaKind is the kind of a media item embedded in a post or comment.
+type MediaKind string
+
+const (
+	// MediaKindImage is an uploaded image.
+	MediaKindImage MediaKind = "image"
+	// MediaKindGIF is a GIF from Giphy.
+	MediaKindGIF MediaKind = "giphy"
+	// MediaKindEmote is one of a subreddit's emotes.
+	MediaKindEmote MediaKind = "emote"
+)
+
+// MediaMetadata describes a media item embedded in a post or comment.
+type MediaMetadata struct {
+	ID   string    `json:"id,omitempty"`
+	Kind MediaKind `json:"-"`
+	// Either "valid", or "unprocessed" if the media is still being processed.
+	Status string `json:"status,omitempty"`
+	// Either "Image" or "AnimatedImage".
+	Type     string `json:"e,omitempty"`
+	MIMEType string `json:"m,omitempty"`
+
+	// The media at its original size.
+	Source *MediaSource `json:"s,omitempty"`
+	// Smaller versions of the media.
+	Previews []*MediaSource `json:"p,omitempty"`
+
+	// For GIFs, the GIF's page on Giphy.
+	ExternalURL string `json:"ext,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *MediaMetadata) UnmarshalJSON(b []byte) error {
+	type mediaMetadata MediaMetadata
+	err := json.Unmarshal(b, (*mediaMetadata)(m))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case strings.HasPrefix(m.ID, "giphy|"):
+		m.Kind = MediaKindGIF
+	case strings.HasPrefix(m.ID, "emote|"):
+		m.Kind = MediaKindEmote
+	default:
+		m.Kind = MediaKindImage
+	}
+
+	return nil
+}
+
+// URL returns the URL of the media at its original size.
+func (m *MediaMetadata) URL() string {
+	if m.Source == nil {
+		return ""
+	}
+	if m.Source.URL != "" {
+		return m.Source.URL
+	}
+	return m.Source.GIF
+}
+
+// MediaSource is a version of a media item.
+type MediaSource struct {
+	Width  int `json:"x"`
+	Height int `json:"y"`
+	// The URL of the image. Empty for GIFs, which have GIF and MP4 instead.
+	URL string `json:"u,omitempty"`
+	GIF string `json:"gif,omitempty"`
+	MP4 string `json:"mp4,omitempty"`
+}
+
 // Replies holds replies to a comment.
 // It contains both comments and "more" comments, which are entrypoints to other
 // comments that were left out.
@@ -529,11 +757,15 @@ type Post struct {
 	ID      string     `json:"id,omitempty"`
 	FullID  string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
-	Edited  *Timestamp `json:"edited,omitempty"`
+	// Nil if it hasn't been edited.
+	Edited *Timestamp `json:"edited,omitempty"`
 
 	Permalink string `json:"permalink,omitempty"`
 	URL       string `json:"url,omitempty"`
 
+	// Full ID of the post this one was crossposted from, if it is a crosspost.
+	CrosspostParentID string `json:"crosspost_parent,omitempty"`
+
 	Title string `json:"title,omitempty"`
 	Body  string `json:"selftext,omitempty"`
 
@@ -555,10 +787,246 @@ type Post struct {
 
 	Spoiler    bool `json:"spoiler"`
 	Locked     bool `json:"locked"`
+	Archived   bool `json:"archived"`
 	NSFW       bool `json:"over_18"`
 	IsSelfPost bool `json:"is_self"`
 	Saved      bool `json:"saved"`
 	Stickied   bool `json:"stickied"`
+	// Whether you've hidden the post.
+	Hidden bool `json:"hidden"`
+	// Whether you've clicked the post's link.
+	Clicked bool `json:"clicked"`
+
+	// If the post is an event, e.g. a scheduled AMA, when it starts and ends.
+	EventStart  *Timestamp `json:"event_start,omitempty"`
+	EventEnd    *Timestamp `json:"event_end,omitempty"`
+	EventIsLive bool       `json:"event_is_live"`
+
+	// The awards the post was given.
+	Awards []*Award `json:"all_awardings,omitempty"`
+
+	// Preview images of the post's link or media, if Reddit generated any.
+	Preview *PostPreview `json:"preview,omitempty"`
+	// The video or embed of the post, e.g. a Reddit-hosted video or a YouTube video.
+	Media *PostMedia `json:"secure_media,omitempty"`
+	// Media embedded in the post's body, or the images of a gallery, keyed by their ID.
+	MediaMetadata map[string]*MediaMetadata `json:"media_metadata,omitempty"`
+	IsGallery     bool                      `json:"is_gallery"`
+	// The images of a gallery post, in order. Their media is in MediaMetadata.
+	Gallery []*GalleryItem `json:"-"`
+	Poll    *Poll          `json:"poll_data,omitempty"`
+	// If the post is a crosspost, the post it was crossposted from.
+	Crossposts []*Post `json:"crosspost_parent_list,omitempty"`
+
+	// If the post was removed and you're allowed to see by whom, e.g. you're a moderator.
+	Removal *Removal `json:"-"`
+
+	// The data of the post as Reddit sent it, if the client was created with WithRawThingJSON.
+	// Useful for fields the library doesn't model yet.
+	RawJSON json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Post) UnmarshalJSON(b []byte) error {
+	type post Post
+	root := struct {
+		*post
+		removalFields
+		GalleryData *struct {
+			Items []*GalleryItem `json:"items"`
+		} `json:"gallery_data"`
+	}{post: (*post)(p)}
+
+	err := json.Unmarshal(b, &root)
+	if err != nil {
+		return err
+	}
+
+	p.Removal = root.removalFields.removal()
+	if p.Edited != nil && p.Edited.IsZero() {
+		// Reddit sends false for posts that haven't been edited.
+		p.Edited = nil
+	}
+	if len(p.Awards) == 0 {
+		p.Awards = nil
+	}
+	if len(p.Crossposts) == 0 {
+		p.Crossposts = nil
+	}
+	if root.GalleryData != nil {
+		p.Gallery = root.GalleryData.Items
+	}
+	return nil
+}
+
+// PostPreview holds the preview images of a post.
+type PostPreview struct {
+	Images []*PreviewImage `json:"images,omitempty"`
+	// Whether the preview is shown by default.
+	Enabled bool `json:"enabled"`
+}
+
+// PreviewImage is a preview image of a post, in its original and smaller sizes.
+type PreviewImage struct {
+	ID          string           `json:"id,omitempty"`
+	Source      *PreviewSource   `json:"source,omitempty"`
+	Resolutions []*PreviewSource `json:"resolutions,omitempty"`
+	// Other versions of the image, e.g. "gif" and "mp4" for animated images, or "nsfw"
+	// and "obfuscated" for blurred ones.
+	Variants map[string]*PreviewImage `json:"variants,omitempty"`
+}
+
+// PreviewSource is one size of a preview image.
+type PreviewSource struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// PostMedia is the video or embed of a post.
+type PostMedia struct {
+	// The site the media is from, e.g. youtube.com, or "liveupdate" for a live thread.
+	Type        string       `json:"type,omitempty"`
+	OEmbed      *OEmbed      `json:"oembed,omitempty"`
+	RedditVideo *RedditVideo `json:"reddit_video,omitempty"`
+	// The ID of the live thread, if the post links to one.
+	LiveThreadID string `json:"event_id,omitempty"`
+}
+
+// OEmbed describes media from another site embedded in a post.
+type OEmbed struct {
+	Type         string `json:"type,omitempty"`
+	Title        string `json:"title,omitempty"`
+	ProviderName string `json:"provider_name,omitempty"`
+	ProviderURL  string `json:"provider_url,omitempty"`
+	AuthorName   string `json:"author_name,omitempty"`
+	AuthorURL    string `json:"author_url,omitempty"`
+	// The HTML used to embed the media.
+	HTML   string `json:"html,omitempty"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth  int    `json:"thumbnail_width"`
+	ThumbnailHeight int    `json:"thumbnail_height"`
+}
+
+// RedditVideo is a video hosted by Reddit.
+type RedditVideo struct {
+	// A direct link to the video, without audio.
+	FallbackURL      string `json:"fallback_url,omitempty"`
+	HLSURL           string `json:"hls_url,omitempty"`
+	DASHURL          string `json:"dash_url,omitempty"`
+	ScrubberMediaURL string `json:"scrubber_media_url,omitempty"`
+
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	// In seconds.
+	Duration int  `json:"duration"`
+	IsGIF    bool `json:"is_gif"`
+	// Either "completed", or the stage the video is at in being processed.
+	TranscodingStatus string `json:"transcoding_status,omitempty"`
+}
+
+// GalleryItem is an image of a gallery post.
+type GalleryItem struct {
+	ID int `json:"id"`
+	// The key of the image in the post's MediaMetadata.
+	MediaID string `json:"media_id"`
+	Caption string `json:"caption,omitempty"`
+	// The link attached to the image, if there is one.
+	OutboundURL string `json:"outbound_url,omitempty"`
+}
+
+// Poll is the poll of a post.
+type Poll struct {
+	Options []*PollOption `json:"options"`
+	// Only included once voting has ended, or if you've voted.
+	TotalVotes *int       `json:"total_vote_count,omitempty"`
+	VotingEnds *Timestamp `json:"-"`
+	// The ID of the option you voted for, if you voted.
+	UserSelection string `json:"user_selection,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Poll) UnmarshalJSON(b []byte) error {
+	type poll Poll
+	root := struct {
+		*poll
+		// In milliseconds.
+		VotingEnds int64 `json:"voting_end_timestamp"`
+	}{poll: (*poll)(p)}
+
+	err := json.Unmarshal(b, &root)
+	if err != nil {
+		return err
+	}
+
+	if root.VotingEnds != 0 {
+		p.VotingEnds = &Timestamp{time.Unix(0, root.VotingEnds*int64(time.Millisecond)).UTC()}
+	}
+	return nil
+}
+
+// PollOption is an option of a poll.
+type PollOption struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	// Only included once voting has ended, or if you've voted.
+	VoteCount *int `json:"vote_count,omitempty"`
+}
+
+// Removal describes the removal of a post or comment.
+type Removal struct {
+	// The moderator who removed it, if known.
+	By string
+	// Who removed it. One of: moderator, automod_filtered, author, deleted, reddit,
+	// anti_evil_ops, copyright_takedown, content_takedown.
+	Category string
+	// The title of the removal reason, or the note left by the moderator, if there is one.
+	Reason string
+	// When it was removed, if known.
+	At *Timestamp
+}
+
+// removalFields are the overlapping fields Reddit uses to describe a removal.
+type removalFields struct {
+	// The moderator's username, or true if you may know that it was removed but not by whom.
+	BannedBy       interface{} `json:"banned_by"`
+	BannedAt       *Timestamp  `json:"banned_at_utc"`
+	RemovedBy      string      `json:"removed_by"`
+	Category       string      `json:"removed_by_category"`
+	RemovalReason  string      `json:"removal_reason"`
+	ModReasonTitle string      `json:"mod_reason_title"`
+	ModReasonBy    string      `json:"mod_reason_by"`
+}
+
+func (f *removalFields) removal() *Removal {
+	bannedBy, _ := f.BannedBy.(string)
+	bannedByUnknown, _ := f.BannedBy.(bool)
+
+	r := &Removal{Category: f.Category, At: f.BannedAt}
+	switch {
+	case f.RemovedBy != "":
+		r.By = f.RemovedBy
+	case bannedBy != "":
+		r.By = bannedBy
+	default:
+		r.By = f.ModReasonBy
+	}
+
+	r.Reason = f.ModReasonTitle
+	if r.Reason == "" {
+		r.Reason = f.RemovalReason
+	}
+
+	if r.By == "" && r.Category == "" && r.Reason == "" && r.At == nil && !bannedByUnknown {
+		return nil
+	}
+	if r.Category == "" && r.By != "" {
+		r.Category = "moderator"
+	}
+	return r
 }
 
 // Subreddit holds information about a subreddit
@@ -581,6 +1049,28 @@ type Subreddit struct {
 	UserIsMod       bool `json:"user_is_moderator"`
 	Subscribed      bool `json:"user_is_subscriber"`
 	Favorite        bool `json:"user_has_favorited"`
+	UserIsBanned    bool `json:"user_is_banned"`
+
+	IconURL          string `json:"icon_img,omitempty"`
+	CommunityIconURL string `json:"community_icon,omitempty"`
+	BannerURL        string `json:"banner_img,omitempty"`
+	// The banner shown on new Reddit.
+	BannerBackgroundURL string `json:"banner_background_image,omitempty"`
+	PrimaryColor        string `json:"primary_color,omitempty"`
+	KeyColor            string `json:"key_color,omitempty"`
+
+	// One of: any, link, self.
+	SubmissionType string `json:"submission_type,omitempty"`
+	AllowImages    bool   `json:"allow_images"`
+	AllowVideos    bool   `json:"allow_videos"`
+	AllowGalleries bool   `json:"allow_galleries"`
+	AllowPolls     bool   `json:"allow_polls"`
+	// Whether the subreddit's wiki is enabled. Only included when getting a single subreddit.
+	WikiEnabled *bool `json:"wiki_enabled,omitempty"`
+
+	// The data of the subreddit as Reddit sent it, if the client was created with WithRawThingJSON.
+	// Useful for fields the library doesn't model yet.
+	RawJSON json.RawMessage `json:"-"`
 }
 
 // PostAndComments is a post and its comments.
@@ -605,7 +1095,12 @@ func (pc *PostAndComments) UnmarshalJSON(data []byte) error {
 	listing1, _ := root[0].Listing()
 	listing2, _ := root[1].Listing()
 
-	pc.Post = listing1.Posts()[0]
+	posts := listing1.Posts()
+	if len(posts) == 0 {
+		return errNoPost
+	}
+
+	pc.Post = posts[0]
 	pc.Comments = listing2.Comments()
 	if len(listing2.Mores()) > 0 {
 		pc.More = listing2.Mores()[0]
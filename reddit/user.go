@@ -2,9 +2,12 @@ package reddit
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
 // UserService handles communication with the user
@@ -24,13 +27,62 @@ type User struct {
 
 	PostKarma    int `json:"link_karma"`
 	CommentKarma int `json:"comment_karma"`
+	// Karma from awards the user received. Only included when getting a single user.
+	AwardeeKarma int `json:"awardee_karma"`
+	// Karma from awards the user gave. Only included when getting a single user.
+	AwarderKarma int `json:"awarder_karma"`
+	// All of the user's karma combined. Only included when getting a single user.
+	TotalKarma int `json:"total_karma"`
+
+	IconURL string `json:"icon_img,omitempty"`
 
 	IsFriend         bool              `json:"is_friend"`
 	IsEmployee       bool              `json:"is_employee"`
+	IsMod            bool              `json:"is_mod"`
+	IsGold           bool              `json:"is_gold"`
+	Verified         bool              `json:"verified"`
 	HasVerifiedEmail bool              `json:"has_verified_email"`
 	NSFW             bool              `json:"over_18"`
 	IsSuspended      bool              `json:"is_suspended"`
 	Subreddit        SubredditSettings `json:"subreddit"`
+
+	// The user's profile, i.e. their u/{username} subreddit, whose settings are in Subreddit.
+	// Not included for suspended users, or when listing users.
+	Profile *UserProfile `json:"-"`
+}
+
+// UserProfile identifies a user's profile, i.e. their u/{username} subreddit.
+type UserProfile struct {
+	FullID       string `json:"name,omitempty"`
+	Name         string `json:"display_name,omitempty"`
+	NamePrefixed string `json:"display_name_prefixed,omitempty"`
+	URL          string `json:"url,omitempty"`
+	IconURL      string `json:"icon_img,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (u *User) UnmarshalJSON(b []byte) error {
+	type user User
+	// The profile's settings and what identifies it are both under the "subreddit" key,
+	// so they're decoded together, in one pass.
+	root := struct {
+		*user
+		Subreddit *struct {
+			SubredditSettings
+			UserProfile
+		} `json:"subreddit"`
+	}{user: (*user)(u)}
+
+	err := json.Unmarshal(b, &root)
+	if err != nil {
+		return err
+	}
+
+	if root.Subreddit != nil {
+		u.Subreddit = root.Subreddit.SubredditSettings
+		u.Profile = &root.Subreddit.UserProfile
+	}
+	return nil
 }
 
 // UserSummary represents a Reddit user, but
@@ -70,6 +122,38 @@ func (s *UserService) Get(ctx context.Context, username string) (*User, *Respons
 	return user, resp, nil
 }
 
+// MeetsThreshold reports whether the user has at least minKarma karma (post and comment karma
+// combined) and an account at least minAge old. Users are cached (see WithUserCache), so it can
+// be called for every incoming post or comment; on a cache hit, no request is made and the
+// returned *Response is nil.
+func (s *UserService) MeetsThreshold(ctx context.Context, username string, minKarma int, minAge time.Duration) (bool, *Response, error) {
+	key := strings.ToLower(username)
+
+	var resp *Response
+	user, ok := s.client.userCache.get(key)
+	if !ok {
+		u, r, err := s.Get(ctx, username)
+		if err != nil {
+			return false, r, err
+		}
+		if u == nil {
+			return false, r, fmt.Errorf("user %s: not found", username)
+		}
+		s.client.userCache.add(key, u)
+		user, resp = u, r
+	}
+
+	u := user.(*User)
+	if u.PostKarma+u.CommentKarma < minKarma {
+		return false, resp, nil
+	}
+	if minAge > 0 && (u.Created == nil || time.Since(u.Created.Time) < minAge) {
+		return false, resp, nil
+	}
+
+	return true, resp, nil
+}
+
 // GetMultipleByID returns multiple users from their full IDs.
 // The response body is a map where the keys are the IDs (if they exist), and the value is the user.
 func (s *UserService) GetMultipleByID(ctx context.Context, ids ...string) (map[string]*UserSummary, *Response, error) {
@@ -138,6 +222,17 @@ func (s *UserService) OverviewOf(ctx context.Context, username string, opts *Lis
 	return l.Posts(), l.Comments(), resp, nil
 }
 
+// OverviewThings returns a list of your posts and comments, in the order they were made.
+func (s *UserService) OverviewThings(ctx context.Context, opts *ListUserOverviewOptions) ([]Thing, *Response, error) {
+	return s.OverviewThingsOf(ctx, s.client.Username, opts)
+}
+
+// OverviewThingsOf returns a list of the user's posts and comments, in the order they were made.
+func (s *UserService) OverviewThingsOf(ctx context.Context, username string, opts *ListUserOverviewOptions) ([]Thing, *Response, error) {
+	path := fmt.Sprintf("user/%s/overview", username)
+	return s.client.getThings(ctx, path, opts)
+}
+
 // Posts returns a list of your posts.
 func (s *UserService) Posts(ctx context.Context, opts *ListUserOverviewOptions) ([]*Post, *Response, error) {
 	return s.PostsOf(ctx, s.client.Username, opts)
@@ -178,6 +273,12 @@ func (s *UserService) Saved(ctx context.Context, opts *ListUserOverviewOptions)
 	return l.Posts(), l.Comments(), resp, nil
 }
 
+// SavedThings returns a list of the user's saved posts and comments, in the order they were saved.
+func (s *UserService) SavedThings(ctx context.Context, opts *ListUserOverviewOptions) ([]Thing, *Response, error) {
+	path := fmt.Sprintf("user/%s/saved", s.client.Username)
+	return s.client.getThings(ctx, path, opts)
+}
+
 // Upvoted returns a list of your upvoted posts.
 func (s *UserService) Upvoted(ctx context.Context, opts *ListUserOverviewOptions) ([]*Post, *Response, error) {
 	return s.UpvotedOf(ctx, s.client.Username, opts)
@@ -220,9 +321,14 @@ func (s *UserService) Hidden(ctx context.Context, opts *ListUserOverviewOptions)
 	return l.Posts(), resp, nil
 }
 
-// Gilded returns a list of the user's gilded posts.
+// Gilded returns a list of your gilded posts.
 func (s *UserService) Gilded(ctx context.Context, opts *ListUserOverviewOptions) ([]*Post, *Response, error) {
-	path := fmt.Sprintf("user/%s/gilded", s.client.Username)
+	return s.GildedOf(ctx, s.client.Username, opts)
+}
+
+// GildedOf returns a list of the user's gilded posts.
+func (s *UserService) GildedOf(ctx context.Context, username string, opts *ListUserOverviewOptions) ([]*Post, *Response, error) {
+	path := fmt.Sprintf("user/%s/gilded", username)
 	l, resp, err := s.client.getListing(ctx, path, opts)
 	if err != nil {
 		return nil, resp, err
@@ -251,9 +357,16 @@ func (s *UserService) GetFriendship(ctx context.Context, username string) (*Rela
 
 // Friend a user.
 func (s *UserService) Friend(ctx context.Context, username string) (*Relationship, *Response, error) {
+	return s.FriendWithNote(ctx, username, "")
+}
+
+// FriendWithNote friends a user and leaves a note about them, which only you can see.
+// Leaving a note requires Reddit Premium. If the user is already your friend, the note is updated.
+func (s *UserService) FriendWithNote(ctx context.Context, username, note string) (*Relationship, *Response, error) {
 	body := struct {
 		Username string `json:"name"`
-	}{username}
+		Note     string `json:"note,omitempty"`
+	}{username, note}
 
 	path := fmt.Sprintf("api/v1/me/friends/%s", username)
 	req, err := s.client.NewJSONRequest(http.MethodPut, path, body)
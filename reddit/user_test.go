@@ -18,8 +18,37 @@ var expectedUser = &User{
 
 	PostKarma:    8239,
 	CommentKarma: 130514,
+	AwardeeKarma: 104,
+	AwarderKarma: 15,
+	TotalKarma:   138872,
 
+	IconURL: "https://www.redditstatic.com/avatars/avatar_default_16_25B79F.png",
+
+	IsMod:            true,
+	Verified:         true,
 	HasVerifiedEmail: true,
+	Subreddit: SubredditSettings{
+		Type:                             String("user"),
+		Title:                            String(""),
+		Description:                      String(""),
+		Sidebar:                          String(""),
+		AllowFreeFormReports:             Bool(true),
+		DisableContributorRequests:       Bool(false),
+		SubmitLinkPostLabel:              String(""),
+		SubmitTextPostLabel:              String(""),
+		ShowContentThumbnails:            Bool(true),
+		MobileColour:                     String(""),
+		NSFW:                             Bool(false),
+		AllowDiscoveryInHighTrafficFeeds: Bool(true),
+	},
+
+	Profile: &UserProfile{
+		FullID:       "t5_test",
+		Name:         "u_Test_User",
+		NamePrefixed: "u/Test_User",
+		URL:          "/user/Test_User/",
+		IconURL:      "https://www.redditstatic.com/avatars/avatar_default_16_25B79F.png",
+	},
 }
 
 var expectedUsers = map[string]*UserSummary{
@@ -50,7 +79,6 @@ var expectedPost = &Post{
 	ID:      "gczwql",
 	FullID:  "t3_gczwql",
 	Created: &Timestamp{time.Date(2020, 5, 3, 22, 46, 25, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	Permalink: "/r/redditdev/comments/gczwql/get_userusernamegilded_does_it_return_other_users/",
 	URL:       "https://www.reddit.com/r/redditdev/comments/gczwql/get_userusernamegilded_does_it_return_other_users/",
@@ -79,14 +107,14 @@ var expectedComment = &Comment{
 	ID:      "f0zsa37",
 	FullID:  "t1_f0zsa37",
 	Created: &Timestamp{time.Date(2019, 9, 21, 21, 38, 16, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 	ParentID:  "t3_d7ejpn",
 	Permalink: "/r/apple/comments/d7ejpn/im_giving_away_an_iphone_11_pro_to_a_commenter_at/f0zsa37/",
 
-	Body:     "Thank you!",
-	Author:   "v_95",
-	AuthorID: "t2_164ab8",
+	Body:            "Thank you!",
+	Author:          "v_95",
+	AuthorID:        "t2_164ab8",
+	AuthorFlairType: "text",
 
 	SubredditName:         "apple",
 	SubredditNamePrefixed: "r/apple",
@@ -102,6 +130,7 @@ var expectedComment = &Comment{
 	PostPermalink:   "https://www.reddit.com/r/apple/comments/d7ejpn/im_giving_away_an_iphone_11_pro_to_a_commenter_at/",
 	PostAuthor:      "iamthatis",
 	PostNumComments: Int(89751),
+	Archived:        true,
 }
 
 var expectedRelationship = &Relationship{
@@ -142,6 +171,15 @@ var expectedUserSubreddits = []*Subreddit{
 		Title:        "nickofnight",
 		Description:  "Stories written for Writing Prompts, NoSleep, and originals. Current series: The Carnival of Night ",
 		Type:         "user",
+
+		IconURL:        "https://styles.redditmedia.com/t5_3kefx/styles/profileIcon_w1vytyimts541.png?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=e722798c6253d3ae3990bf42c3ae844d7c2a924b",
+		BannerURL:      "https://b.thumbs.redditmedia.com/9KgnD8_adeV_jCLhObwY-rhHrESHgTP9_JQLmIH_GWQ.png",
+		KeyColor:       "#222222",
+		SubmissionType: "any",
+		AllowImages:    true,
+		AllowVideos:    true,
+		AllowGalleries: true,
+		AllowPolls:     true,
 	},
 	{
 		ID:      "3knn1",
@@ -155,6 +193,14 @@ var expectedUserSubreddits = []*Subreddit{
 		Description:          "In nineteen ninety eight the undertaker threw mankind off hеll in a cell, and plummeted sixteen feet through an announcer's table.",
 		Type:                 "user",
 		SuggestedCommentSort: "qa",
+
+		IconURL:        "https://styles.redditmedia.com/t5_3knn1/styles/profileIcon_b51xzp4vbvs41.jpg?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=6535d6f05d037d43d72217899d3f81aba4fb442d",
+		BannerURL:      "https://b.thumbs.redditmedia.com/VjGAJxyj4OL3Ghb1TzrGFtf1QT3D-r1kX72q7uSv8iA.png",
+		SubmissionType: "any",
+		AllowImages:    true,
+		AllowVideos:    true,
+		AllowGalleries: true,
+		AllowPolls:     true,
 	},
 }
 
@@ -193,11 +239,41 @@ func TestUserService_Get(t *testing.T) {
 	})
 
 	user, _, err := client.User.Get(ctx, "Test_User")
-	fmt.Println(user, err)
 	require.NoError(t, err)
 	require.Equal(t, expectedUser, user)
 }
 
+func TestUserService_MeetsThreshold(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/user/get.json")
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/user/Test_User/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		counter++
+		fmt.Fprint(w, blob)
+	})
+
+	ok, resp, err := client.User.MeetsThreshold(ctx, "Test_User", 1000, time.Hour*24*365)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.True(t, ok)
+
+	// the user is cached by now, so no more requests are made
+	ok, resp, err = client.User.MeetsThreshold(ctx, "test_user", 1000000, 0)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	require.False(t, ok)
+
+	ok, _, err = client.User.MeetsThreshold(ctx, "Test_User", 0, time.Hour*24*365*100)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.Equal(t, 1, counter)
+}
+
 func TestUserService_GetMultipleByID(t *testing.T) {
 	client, mux := setup(t)
 
@@ -290,6 +366,23 @@ func TestUserService_OverviewOf(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestUserService_OverviewThingsOf(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/user/user2/overview", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	things, resp, err := client.User.OverviewThingsOf(ctx, "user2", nil)
+	require.NoError(t, err)
+	require.Equal(t, []Thing{expectedPost, expectedComment}, things)
+	require.Equal(t, "t1_f0zsa37", resp.After)
+}
+
 func TestUserService_Overview_Options(t *testing.T) {
 	client, mux := setup(t)
 
@@ -508,6 +601,33 @@ func TestUserService_Saved_Options(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestUserService_Saved_Type(t *testing.T) {
+	client, mux := setup(t)
+	client.Username = "user1"
+
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/user/user1/saved", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("type", "comments")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, _, err = client.User.Saved(ctx, &ListUserOverviewOptions{Type: "comments"})
+	require.NoError(t, err)
+
+	_, _, err = client.User.SavedThings(ctx, &ListUserOverviewOptions{Type: "comments"})
+	require.NoError(t, err)
+}
 func TestUserService_Upvoted(t *testing.T) {
 	client, mux := setup(t)
 
@@ -688,6 +808,38 @@ func TestUserService_Gilded(t *testing.T) {
 	require.Equal(t, "t3_gczwql", resp.After)
 }
 
+func TestUserService_GildedOf(t *testing.T) {
+	client, mux := setup(t)
+
+	// we'll use this, similar payloads
+	blob, err := readFileContents("../testdata/user/submitted.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/user/user2/gilded", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "5")
+		form.Set("t", "year")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.User.GildedOf(ctx, "user2", &ListUserOverviewOptions{
+		ListOptions: ListOptions{Limit: 5},
+		Time:        "year",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, posts, 1)
+	require.Equal(t, expectedPost, posts[0])
+	require.Equal(t, "t3_gczwql", resp.After)
+}
+
 func TestUserService_GetFriendship(t *testing.T) {
 	client, mux := setup(t)
 
@@ -729,6 +881,33 @@ func TestUserService_Friend(t *testing.T) {
 	require.Equal(t, expectedRelationship, relationship)
 }
 
+func TestUserService_FriendWithNote(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/user/friend.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/me/friends/test123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+
+		var request struct {
+			Username string `json:"name"`
+			Note     string `json:"note"`
+		}
+
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+		require.Equal(t, "test123", request.Username)
+		require.Equal(t, "met at GopherCon", request.Note)
+
+		fmt.Fprint(w, blob)
+	})
+
+	relationship, _, err := client.User.FriendWithNote(ctx, "test123", "met at GopherCon")
+	require.NoError(t, err)
+	require.Equal(t, expectedRelationship, relationship)
+}
+
 func TestUserService_Unfriend(t *testing.T) {
 	client, mux := setup(t)
 
@@ -37,6 +37,8 @@ const (
 	widgetKindModerators       = "moderators"
 	widgetKindSubredditRules   = "subreddit-rules"
 	widgetKindCustom           = "custom"
+	widgetKindCalendar         = "calendar"
+	widgetKindPostFlair        = "post-flair"
 )
 
 type rootWidget struct {
@@ -73,6 +75,10 @@ func (w *rootWidget) UnmarshalJSON(data []byte) error {
 		w.Data = new(SubredditRulesWidget)
 	case widgetKindCustom:
 		w.Data = new(CustomWidget)
+	case widgetKindCalendar:
+		w.Data = new(CalendarWidget)
+	case widgetKindPostFlair:
+		w.Data = new(PostFlairWidget)
 	default:
 		return fmt.Errorf("unrecognized widget kind: %q", root.Kind)
 	}
@@ -256,6 +262,84 @@ type CustomWidget struct {
 	Images        []*WidgetImage `json:"imageData,omitempty"`
 }
 
+// CalendarWidget displays upcoming events from a public Google Calendar.
+type CalendarWidget struct {
+	widget
+
+	Name             string                 `json:"shortName,omitempty"`
+	GoogleCalendarID string                 `json:"googleCalendarId,omitempty"`
+	RequiresSync     bool                   `json:"requiresSync"`
+	Configuration    *CalendarWidgetConfig  `json:"configuration,omitempty"`
+	Events           []*CalendarWidgetEvent `json:"data,omitempty"`
+}
+
+// CalendarWidgetConfig configures what a calendar widget shows about its events.
+type CalendarWidgetConfig struct {
+	// Between 1 and 50.
+	NumberOfEvents  int  `json:"numEvents"`
+	ShowTitle       bool `json:"showTitle"`
+	ShowDate        bool `json:"showDate"`
+	ShowTime        bool `json:"showTime"`
+	ShowLocation    bool `json:"showLocation"`
+	ShowDescription bool `json:"showDescription"`
+}
+
+// CalendarWidgetEvent is an event displayed in a calendar widget.
+type CalendarWidgetEvent struct {
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Location    string     `json:"location,omitempty"`
+	Start       *Timestamp `json:"startTime,omitempty"`
+	End         *Timestamp `json:"endTime,omitempty"`
+	AllDay      bool       `json:"allDay"`
+}
+
+// PostFlairWidget displays the subreddit's post flairs, as links to the posts that have them.
+type PostFlairWidget struct {
+	widget
+
+	Name string `json:"shortName,omitempty"`
+	// One of: cloud, list.
+	Display   string                 `json:"display,omitempty"`
+	Templates []*WidgetFlairTemplate `json:"templates,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (w *PostFlairWidget) UnmarshalJSON(data []byte) error {
+	root := new(struct {
+		widget
+
+		Name      string                          `json:"shortName"`
+		Display   string                          `json:"display"`
+		Order     []string                        `json:"order"`
+		Templates map[string]*WidgetFlairTemplate `json:"templates"`
+	})
+
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	w.widget = root.widget
+	w.Name = root.Name
+	w.Display = root.Display
+	for _, id := range root.Order {
+		if t, ok := root.Templates[id]; ok {
+			w.Templates = append(w.Templates, t)
+		}
+	}
+
+	return nil
+}
+
+// WidgetFlairTemplate is a post flair displayed in a post flair widget.
+type WidgetFlairTemplate struct {
+	ID              string `json:"templateId,omitempty"`
+	Text            string `json:"text,omitempty"`
+	TextColor       string `json:"textColor,omitempty"`
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+}
+
 // WidgetStyle contains style information for the widget.
 type WidgetStyle struct {
 	HeaderColor     string `json:"headerColor,omitempty"`
@@ -448,6 +532,28 @@ func (s *WidgetService) Create(ctx context.Context, subreddit string, request Wi
 	return root.Data, resp, nil
 }
 
+// Update a widget via its id. The request replaces the widget entirely, so it should be of the
+// same kind as the widget and contain all of its fields, not just the ones being changed.
+func (s *WidgetService) Update(ctx context.Context, subreddit, id string, request WidgetCreateRequest) (Widget, *Response, error) {
+	if request == nil {
+		return nil, nil, errors.New("WidgetCreateRequest: cannot be nil")
+	}
+
+	path := fmt.Sprintf("r/%s/api/widget/%s", subreddit, id)
+	req, err := s.client.NewJSONRequest(http.MethodPut, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootWidget)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
 // Delete a widget via its id.
 func (s *WidgetService) Delete(ctx context.Context, subreddit, id string) (*Response, error) {
 	path := fmt.Sprintf("r/%s/api/widget/%s", subreddit, id)
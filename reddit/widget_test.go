@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -169,6 +170,54 @@ var expectedWidgets = []Widget{
 			},
 		},
 	},
+
+	&CalendarWidget{
+		widget: widget{
+			ID:    "widget_15q2ef0rh4x7d",
+			Kind:  "calendar",
+			Style: &WidgetStyle{},
+		},
+		Name:             "events",
+		GoogleCalendarID: "testcalendar@group.calendar.google.com",
+		Configuration: &CalendarWidgetConfig{
+			NumberOfEvents: 5,
+			ShowTitle:      true,
+			ShowDate:       true,
+			ShowTime:       true,
+		},
+		Events: []*CalendarWidgetEvent{
+			{
+				Title:       "AMA",
+				Description: "ask us anything",
+				Start:       &Timestamp{time.Date(2020, 9, 26, 17, 0, 0, 0, time.UTC)},
+				End:         &Timestamp{time.Date(2020, 9, 26, 19, 0, 0, 0, time.UTC)},
+			},
+		},
+	},
+
+	&PostFlairWidget{
+		widget: widget{
+			ID:    "widget_15q2eqcq5w8gc",
+			Kind:  "post-flair",
+			Style: &WidgetStyle{},
+		},
+		Name:    "flairs",
+		Display: "list",
+		Templates: []*WidgetFlairTemplate{
+			{
+				ID:              "b8a1c822-3feb-11e8-88e1-0e5f55d58ce0",
+				Text:            "News",
+				TextColor:       "light",
+				BackgroundColor: "#0079d3",
+			},
+			{
+				ID:              "d2b6e4c6-3feb-11e8-a88b-0e1c2b3d4e5f",
+				Text:            "Discussion",
+				TextColor:       "dark",
+				BackgroundColor: "#ffd635",
+			},
+		},
+	},
 }
 
 func TestWidgetService_Get(t *testing.T) {
@@ -237,6 +286,50 @@ func TestWidgetService_Create(t *testing.T) {
 	}, createdWidget)
 }
 
+func TestWidgetService_Update(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/widget/id123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+
+		body := new(struct {
+			Kind string `json:"kind"`
+			Name string `json:"shortName"`
+			Text string `json:"text"`
+		})
+
+		err := json.NewDecoder(r.Body).Decode(body)
+		require.NoError(t, err)
+		require.Equal(t, "textarea", body.Kind)
+		require.Equal(t, "test name", body.Name)
+		require.Equal(t, "updated text", body.Text)
+
+		fmt.Fprint(w, `{
+			"text": "updated text",
+			"kind": "textarea",
+			"shortName": "test name",
+			"id": "id123"
+		}`)
+	})
+
+	_, _, err := client.Widget.Update(ctx, "testsubreddit", "id123", nil)
+	require.EqualError(t, err, "WidgetCreateRequest: cannot be nil")
+
+	updatedWidget, _, err := client.Widget.Update(ctx, "testsubreddit", "id123", &TextAreaWidgetCreateRequest{
+		Name: "test name",
+		Text: "updated text",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &TextAreaWidget{
+		widget: widget{
+			ID:   "id123",
+			Kind: "textarea",
+		},
+		Name: "test name",
+		Text: "updated text",
+	}, updatedWidget)
+}
+
 func TestWidgetService_Delete(t *testing.T) {
 	client, mux := setup(t)
 
@@ -22,9 +22,11 @@ type WikiService struct {
 
 // WikiPage is a wiki page in a subreddit.
 type WikiPage struct {
-	Content   string `json:"content_md,omitempty"`
-	Reason    string `json:"reason,omitempty"`
-	MayRevise bool   `json:"may_revise"`
+	Content string `json:"content_md,omitempty"`
+	// The page rendered as HTML.
+	ContentHTML string `json:"content_html,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	MayRevise   bool   `json:"may_revise"`
 
 	RevisionID   string     `json:"revision_id,omitempty"`
 	RevisionDate *Timestamp `json:"revision_date,omitempty"`
@@ -34,9 +36,10 @@ type WikiPage struct {
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (p *WikiPage) UnmarshalJSON(b []byte) error {
 	root := new(struct {
-		Content   string `json:"content_md,omitempty"`
-		Reason    string `json:"reason,omitempty"`
-		MayRevise bool   `json:"may_revise"`
+		Content     string `json:"content_md,omitempty"`
+		ContentHTML string `json:"content_html,omitempty"`
+		Reason      string `json:"reason,omitempty"`
+		MayRevise   bool   `json:"may_revise"`
 
 		RevisionID   string     `json:"revision_id,omitempty"`
 		RevisionDate *Timestamp `json:"revision_date,omitempty"`
@@ -49,6 +52,7 @@ func (p *WikiPage) UnmarshalJSON(b []byte) error {
 	}
 
 	p.Content = root.Content
+	p.ContentHTML = root.ContentHTML
 	p.Reason = root.Reason
 	p.MayRevise = root.MayRevise
 
@@ -62,6 +66,15 @@ func (p *WikiPage) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// WikiPageOptions are options to use when getting a wiki page.
+type WikiPageOptions struct {
+	// The ID of the revision to get. If empty, the most recent version is returned.
+	RevisionID string `url:"v,omitempty"`
+	// The ID of a revision to compare RevisionID against. Reddit returns the diff between
+	// the two revisions as the page's content.
+	DiffRevisionID string `url:"v2,omitempty"`
+}
+
 // WikiPageEditRequest represents a request to edit a wiki page in a subreddit.
 type WikiPageEditRequest struct {
 	Subreddit string `url:"-"`
@@ -69,6 +82,9 @@ type WikiPageEditRequest struct {
 	Content   string `url:"content"`
 	// Optional, up to 256 characters long.
 	Reason string `url:"reason,omitempty"`
+	// Optional, the ID of the revision the edit is based on. If the page has been revised
+	// since, the edit fails with a *WikiEditConflictError instead of overwriting the newer revision.
+	PreviousRevisionID string `url:"previous,omitempty"`
 }
 
 // WikiPagePermissionLevel defines who can edit a specific wiki page in a subreddit.
@@ -175,19 +191,20 @@ func (r *WikiPageRevision) UnmarshalJSON(b []byte) error {
 
 // Page gets a wiki page.
 func (s *WikiService) Page(ctx context.Context, subreddit, page string) (*WikiPage, *Response, error) {
-	return s.PageRevision(ctx, subreddit, page, "")
+	return s.GetPage(ctx, subreddit, page, nil)
 }
 
 // PageRevision gets a wiki page at the version it was at the revisionID provided.
 // If revisionID is an empty string, it will get the most recent version.
 func (s *WikiService) PageRevision(ctx context.Context, subreddit, page, revisionID string) (*WikiPage, *Response, error) {
-	path := fmt.Sprintf("r/%s/wiki/%s", subreddit, page)
-
-	params := struct {
-		RevisionID string `url:"v,omitempty"`
-	}{revisionID}
+	return s.GetPage(ctx, subreddit, page, &WikiPageOptions{RevisionID: revisionID})
+}
 
-	t, resp, err := s.client.getThing(ctx, path, params)
+// GetPage gets a wiki page, with both its markdown source and its rendered HTML,
+// and information about the revision and its author.
+func (s *WikiService) GetPage(ctx context.Context, subreddit, page string, opts *WikiPageOptions) (*WikiPage, *Response, error) {
+	path := fmt.Sprintf("r/%s/wiki/%s", subreddit, page)
+	t, resp, err := s.client.getThing(ctx, path, opts)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -225,7 +242,27 @@ func (s *WikiService) Edit(ctx context.Context, editRequest *WikiPageEditRequest
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusConflict {
+		conflict := &WikiEditConflictError{Subreddit: editRequest.Subreddit, Page: editRequest.Page}
+		if json.NewDecoder(resp.Body).Decode(conflict) == nil {
+			return resp, conflict
+		}
+	}
+	return resp, err
+}
+
+// EditPage edits a wiki page, creating it if it doesn't exist.
+// If previousRevisionID isn't empty and the page has been revised since that revision,
+// the page is left unchanged and a *WikiEditConflictError is returned.
+func (s *WikiService) EditPage(ctx context.Context, subreddit, page, content, reason, previousRevisionID string) (*Response, error) {
+	return s.Edit(ctx, &WikiPageEditRequest{
+		Subreddit:          subreddit,
+		Page:               page,
+		Content:            content,
+		Reason:             reason,
+		PreviousRevisionID: previousRevisionID,
+	})
 }
 
 // Revert a wiki page to a specific revision.
@@ -324,6 +361,10 @@ func (s *WikiService) revisions(ctx context.Context, subreddit, page string, opt
 	}
 
 	if opts != nil {
+		// copy the options so that the caller's, which may be shared, aren't modified
+		copied := *opts
+		opts = &copied
+
 		const idPrefix = "WikiRevision_"
 		if opts.After != "" && !strings.HasPrefix(opts.After, idPrefix) {
 			opts.After = idPrefix + opts.After
@@ -11,9 +11,10 @@ import (
 )
 
 var expectedWikiPage = &WikiPage{
-	Content:   "test reason",
-	Reason:    "this is a reason!",
-	MayRevise: true,
+	Content:     "test reason",
+	ContentHTML: "&lt;!-- SC_OFF --&gt;&lt;div class=\"md wiki\"&gt;&lt;p&gt;test reason&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
+	Reason:      "this is a reason!",
+	MayRevise:   true,
 
 	RevisionID:   "3c4e9fab-ef2c-11ea-90b6-0e9189256887",
 	RevisionDate: &Timestamp{time.Date(2020, 9, 5, 3, 59, 45, 0, time.UTC)},
@@ -53,7 +54,6 @@ var expectedWikiPageDiscussions = []*Post{
 		ID:      "imj8g5",
 		FullID:  "t3_imj8g5",
 		Created: &Timestamp{time.Date(2020, 9, 4, 16, 33, 33, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
 		Permalink: "/r/helloworldtestt/comments/imj8g5/test/",
 		URL:       "https://www.reddit.com/r/helloworldtestt/wiki/index",
@@ -155,6 +155,38 @@ func TestWikiService_PageRevision(t *testing.T) {
 	require.Equal(t, expectedWikiPage, wikiPage)
 }
 
+func TestWikiService_GetPage(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/wiki/page.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/wiki/testpage", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("v", "testrevision")
+		form.Set("v2", "testrevision2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	wikiPage, _, err := client.Wiki.GetPage(ctx, "testsubreddit", "testpage", &WikiPageOptions{
+		RevisionID:     "testrevision",
+		DiffRevisionID: "testrevision2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedWikiPage.Content, wikiPage.Content)
+	require.Equal(t, expectedWikiPage.ContentHTML, wikiPage.ContentHTML)
+	require.Equal(t, expectedWikiPage.RevisionID, wikiPage.RevisionID)
+	require.Equal(t, expectedWikiPage.RevisionDate, wikiPage.RevisionDate)
+	require.Equal(t, expectedWikiPage.RevisionBy.Name, wikiPage.RevisionBy.Name)
+}
+
 func TestWikiService_Pages(t *testing.T) {
 	client, mux := setup(t)
 
@@ -202,6 +234,46 @@ func TestWikiService_Edit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWikiService_EditPage(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/wiki/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("page", "testpage")
+		form.Set("content", "testcontent")
+		form.Set("reason", "testreason")
+		form.Set("previous", "testrevision")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{
+			"message": "Conflict",
+			"reason": "EDIT_CONFLICT",
+			"newcontent": "newer content",
+			"newrevision": "testrevision2",
+			"diffcontent": "<table class=\"diff\"></table>"
+		}`)
+	})
+
+	_, err := client.Wiki.EditPage(ctx, "testsubreddit", "testpage", "testcontent", "testreason", "testrevision")
+	require.EqualError(t, err, `r/testsubreddit wiki page "testpage" was edited by someone else, it is now at revision testrevision2`)
+
+	conflict, ok := err.(*WikiEditConflictError)
+	require.True(t, ok)
+	require.Equal(t, &WikiEditConflictError{
+		Subreddit:     "testsubreddit",
+		Page:          "testpage",
+		NewContent:    "newer content",
+		NewRevisionID: "testrevision2",
+		Diff:          `<table class="diff"></table>`,
+	}, conflict)
+}
+
 func TestWikiService_Revert(t *testing.T) {
 	client, mux := setup(t)
 
@@ -343,13 +415,19 @@ func TestWikiService_RevisionsPage(t *testing.T) {
 		fmt.Fprint(w, blob)
 	})
 
-	wikiPageRevisions, _, err := client.Wiki.RevisionsPage(ctx, "testsubreddit", "testpage", &ListOptions{
+	opts := &ListOptions{
 		Limit:  10,
 		After:  "wikiId1",
 		Before: "wikiId2",
-	})
+	}
+	wikiPageRevisions, _, err := client.Wiki.RevisionsPage(ctx, "testsubreddit", "testpage", opts)
 	require.NoError(t, err)
 	require.Equal(t, expectedWikiPageRevisions, wikiPageRevisions)
+
+	// the options can be reused
+	require.Equal(t, &ListOptions{Limit: 10, After: "wikiId1", Before: "wikiId2"}, opts)
+	_, _, err = client.Wiki.RevisionsPage(ctx, "testsubreddit", "testpage", opts)
+	require.NoError(t, err)
 }
 
 func TestWikiService_Allow(t *testing.T) {
@@ -0,0 +1,251 @@
+package reddittest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode is what a Recorder does with requests.
+type Mode int
+
+const (
+	// ModeReplay answers requests with the responses in the cassette, without sending them.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests and records them, and their responses, to the cassette.
+	ModeRecord
+)
+
+const redacted = "REDACTED"
+
+// redactedHeaders are removed from recorded requests and responses.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// redactedFields are redacted from recorded form and JSON bodies.
+var redactedFields = []string{"access_token", "refresh_token", "password", "client_secret"}
+
+// Interaction is a request and its response, as recorded in a cassette.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request recorded in a cassette.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response recorded in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records requests to the Reddit API, and their responses,
+// to a cassette file, and replays them from it, so that tests using real responses don't need
+// access to the network. Credentials, such as access tokens and passwords, aren't recorded.
+//
+// Use it as the transport of the client's HTTP client:
+//
+//	rec, err := reddittest.NewRecorder("testdata/cassette.json", reddittest.ModeReplay, nil)
+//	client, err := reddit.NewClient(credentials, reddit.WithHTTPClient(&http.Client{Transport: rec}))
+type Recorder struct {
+	path string
+	mode Mode
+	base http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// NewRecorder returns a recorder for the cassette file at path. In ModeReplay, the cassette is
+// read straight away. In ModeRecord, requests are sent with base, or http.DefaultTransport if
+// it's nil, and the cassette is written by Save.
+func NewRecorder(path string, mode Mode, base http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, base: base}
+	if r.base == nil {
+		r.base = http.DefaultTransport
+	}
+
+	if mode == ModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		c := new(cassette)
+		if err := json.Unmarshal(data, c); err != nil {
+			return nil, fmt.Errorf("reddittest: cassette %s: %w", path, err)
+		}
+		r.interactions = c.Interactions
+		r.replayed = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded := &RecordedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: redactHeader(req.Header),
+		Body:   redactBody(req.Header.Get("Content-Type"), body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: recorded,
+		Response: &RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header),
+			Body:       redactBody(resp.Header.Get("Content-Type"), respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay returns the response of the first interaction not replayed yet whose request has the
+// same method, URL and body as the request.
+func (r *Recorder) replay(req *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.replayed[i] {
+			continue
+		}
+		if interaction.Request.Method != recorded.Method ||
+			interaction.Request.URL != recorded.URL ||
+			interaction.Request.Body != recorded.Body {
+			continue
+		}
+
+		r.replayed[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("reddittest: no recorded response to %s %s in cassette %s", req.Method, req.URL, r.path)
+}
+
+// Save writes the interactions recorded so far to the cassette file. It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(&cassette{Interactions: r.interactions}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, data, os.FileMode(0644))
+}
+
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range redactedHeaders {
+		header.Del(name)
+	}
+	if len(header) == 0 {
+		return nil
+	}
+	return header
+}
+
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return string(body)
+		}
+		for _, field := range redactedFields {
+			if _, ok := form[field]; ok {
+				form.Set(field, redacted)
+			}
+		}
+		return form.Encode()
+	case strings.HasPrefix(contentType, "application/json"):
+		var v map[string]interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return string(body)
+		}
+		changed := false
+		for _, field := range redactedFields {
+			if _, ok := v[field]; ok {
+				v[field] = redacted
+				changed = true
+			}
+		}
+		if !changed {
+			return string(body)
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return string(body)
+		}
+		return string(data)
+	}
+
+	return string(body)
+}
@@ -0,0 +1,70 @@
+package reddittest
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	srv := NewServer(t)
+	srv.Handle(http.MethodGet, "r/golang/about", Subreddit(&reddit.Subreddit{
+		ID:     "2rc7j",
+		FullID: "t5_2rc7j",
+		Name:   "golang",
+	}))
+	srv.HandleFunc(http.MethodPost, "api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "secret"})
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "secret", "token_type": "bearer"}`))
+	})
+
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	require.NoError(t, err)
+
+	client, err := reddit.NewClient(reddit.Credentials{}, reddit.WithBaseURL(srv.URL), reddit.WithHTTPClient(&http.Client{Transport: rec}))
+	require.NoError(t, err)
+
+	subreddit, _, err := client.Subreddit.Get(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, "golang", subreddit.Name)
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/v1/access_token", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Basic c2VjcmV0")
+	resp, err := rec.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.NoError(t, rec.Save())
+	srv.Close()
+
+	rec, err = NewRecorder(path, ModeReplay, nil)
+	require.NoError(t, err)
+	require.Len(t, rec.interactions, 2)
+	require.Empty(t, rec.interactions[1].Request.Header.Get("Authorization"))
+	require.Empty(t, rec.interactions[1].Response.Header.Get("Set-Cookie"))
+	require.JSONEq(t, `{"access_token": "REDACTED", "token_type": "bearer"}`, rec.interactions[1].Response.Body)
+
+	client, err = reddit.NewClient(reddit.Credentials{}, reddit.WithBaseURL(srv.URL), reddit.WithHTTPClient(&http.Client{Transport: rec}))
+	require.NoError(t, err)
+
+	subreddit, _, err = client.Subreddit.Get(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, "golang", subreddit.Name)
+
+	// every interaction is replayed once
+	_, _, err = client.Subreddit.Get(ctx, "golang")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reddittest: no recorded response to GET "+srv.URL+"/r/golang/about")
+}
+
+func TestNewRecorder_MissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,192 @@
+// Package reddittest provides a fake Reddit API server for testing code that uses the reddit
+// package, without access to the network.
+//
+// A Server answers the requests it's told to with canned responses, such as the things and
+// listings built with Post, Comment and Listing, fails the test on any other request, and
+// records every request it receives so that they can be checked afterwards:
+//
+//	srv := reddittest.NewServer(t)
+//	srv.Handle(http.MethodGet, "r/golang/new", reddittest.Listing("", reddittest.Post(post)))
+//
+//	client, err := srv.Client()
+//	posts, _, err := client.Subreddit.NewPosts(ctx, "golang", nil)
+//
+// A Recorder records real interactions with the Reddit API to a cassette file instead, and
+// replays them from it.
+package reddittest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+)
+
+// TB is the part of testing.TB used by Server.
+type TB interface {
+	Cleanup(func())
+	Errorf(format string, args ...interface{})
+	Helper()
+}
+
+// Server is a fake Reddit API server.
+type Server struct {
+	*httptest.Server
+
+	t TB
+
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []*Request
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	// The request's path, without the leading slash, e.g. r/golang/new.
+	Path   string
+	Query  url.Values
+	Header http.Header
+	// The request's form body, if it has one.
+	Form url.Values
+	Body []byte
+}
+
+// NewServer starts a Server, which is closed when the test finishes.
+func NewServer(t TB) *Server {
+	s := &Server{t: t, routes: make(map[string]http.HandlerFunc)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Client returns a client that sends its requests to the server.
+// The options are applied after the one that points the client at the server.
+func (s *Server) Client(opts ...reddit.Opt) (*reddit.Client, error) {
+	opts = append([]reddit.Opt{reddit.WithBaseURL(s.URL)}, opts...)
+	return reddit.NewClient(reddit.Credentials{}, opts...)
+}
+
+func routeKey(method, path string) string {
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".json")
+	return method + " " + path
+}
+
+// HandleFunc makes the server answer requests with the method to the path, e.g. r/golang/new,
+// with the handler. Requests are matched on their path only, regardless of their query.
+func (s *Server) HandleFunc(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[routeKey(method, path)] = handler
+}
+
+// Handle makes the server answer requests with the method to the path, e.g. r/golang/new,
+// with the response: as is if it's a string or a []byte, or else encoded as JSON.
+func (s *Server) Handle(method, path string, response interface{}) {
+	var body []byte
+	switch v := response.(type) {
+	case string:
+		body = []byte(v)
+	case []byte:
+		body = v
+	default:
+		var err error
+		body, err = json.Marshal(v)
+		if err != nil {
+			s.t.Helper()
+			s.t.Errorf("reddittest: cannot encode response to %s %s: %v", method, path, err)
+			return
+		}
+	}
+
+	s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+}
+
+// Requests returns the requests the server has received, in the order it received them.
+func (s *Server) Requests() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Request(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	req := &Request{
+		Method: r.Method,
+		Path:   strings.TrimPrefix(r.URL.Path, "/"),
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		req.Form, _ = url.ParseQuery(string(body))
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	handler, ok := s.routes[routeKey(r.Method, r.URL.Path)]
+	s.mu.Unlock()
+
+	if !ok {
+		s.t.Errorf("reddittest: unexpected request %s %s", r.Method, r.URL)
+		http.NotFound(w, r)
+		return
+	}
+
+	r.Body = ioutil.NopCloser(strings.NewReader(string(body)))
+	handler(w, r)
+}
+
+// Thing is a thing in the format the Reddit API returns it in, e.g. a post.
+type Thing struct {
+	Kind string      `json:"kind"`
+	Data interface{} `json:"data"`
+}
+
+// Post returns the post as a thing.
+func Post(post *reddit.Post) *Thing {
+	return &Thing{Kind: reddit.KindPost, Data: post}
+}
+
+// Comment returns the comment as a thing.
+func Comment(comment *reddit.Comment) *Thing {
+	return &Thing{Kind: reddit.KindComment, Data: comment}
+}
+
+// Subreddit returns the subreddit as a thing.
+func Subreddit(subreddit *reddit.Subreddit) *Thing {
+	return &Thing{Kind: reddit.KindSubreddit, Data: subreddit}
+}
+
+// User returns the user as a thing.
+func User(user *reddit.User) *Thing {
+	return &Thing{Kind: reddit.KindUser, Data: user}
+}
+
+// Message returns the message as a thing.
+func Message(message *reddit.Message) *Thing {
+	return &Thing{Kind: reddit.KindMessage, Data: message}
+}
+
+type listingData struct {
+	Children []*Thing `json:"children"`
+	After    string   `json:"after,omitempty"`
+	Dist     int      `json:"dist"`
+}
+
+// Listing returns a listing of the things. After is the full ID of the last thing, if the
+// listing has more pages, and should be empty otherwise.
+func Listing(after string, things ...*Thing) *Thing {
+	if things == nil {
+		things = []*Thing{}
+	}
+	return &Thing{Kind: "Listing", Data: &listingData{Children: things, After: after, Dist: len(things)}}
+}
@@ -0,0 +1,91 @@
+package reddittest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bitcomputing/go-reddit/v2/reddit"
+	"github.com/stretchr/testify/require"
+)
+
+var ctx = context.Background()
+
+type recordingTB struct {
+	cleanups []func()
+	errors   []string
+}
+
+func (t *recordingTB) Cleanup(f func()) { t.cleanups = append(t.cleanups, f) }
+
+func (t *recordingTB) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingTB) Helper() {}
+
+func TestServer_Listing(t *testing.T) {
+	post := &reddit.Post{
+		ID:            "hw6l6a",
+		FullID:        "t3_hw6l6a",
+		Created:       &reddit.Timestamp{Time: time.Date(2020, 7, 18, 10, 26, 7, 0, time.UTC)},
+		Title:         "Test post",
+		Body:          "Hello world",
+		SubredditName: "golang",
+		Author:        "testuser",
+		Score:         10,
+	}
+
+	srv := NewServer(t)
+	srv.Handle(http.MethodGet, "r/golang/new", Listing("t3_hw6l6a", Post(post)))
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+
+	posts, resp, err := client.Subreddit.NewPosts(ctx, "golang", &reddit.ListOptions{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []*reddit.Post{post}, posts)
+	require.Equal(t, "t3_hw6l6a", resp.After)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, http.MethodGet, requests[0].Method)
+	require.Equal(t, "r/golang/new", requests[0].Path)
+	require.Equal(t, url.Values{"limit": {"1"}}, requests[0].Query)
+}
+
+func TestServer_Form(t *testing.T) {
+	srv := NewServer(t)
+	srv.Handle(http.MethodPost, "api/hide", "{}")
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+
+	_, err = client.Post.Hide(ctx, "t3_hw6l6a")
+	require.NoError(t, err)
+
+	requests := srv.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, url.Values{"id": {"t3_hw6l6a"}}, requests[0].Form)
+}
+
+func TestServer_UnexpectedRequest(t *testing.T) {
+	tb := new(recordingTB)
+	srv := NewServer(tb)
+	defer func() {
+		for _, f := range tb.cleanups {
+			f()
+		}
+	}()
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+
+	_, _, err = client.Subreddit.Get(ctx, "golang")
+	require.Error(t, err)
+	require.Len(t, tb.errors, 1)
+	require.Contains(t, tb.errors[0], "reddittest: unexpected request GET /r/golang/about")
+}